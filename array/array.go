@@ -1,9 +1,16 @@
 package array
 
+import (
+	"database/sql/driver"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
 /* Chain
 * Example:
 *   a := []int{1, 2, 3, 4, 5}
-*   b := Array(a).Reverse().Shuffle().Value()
+*   b := []int(Array(a).Reverse())
 *   fmt.Println(b) // [5 4 3 2 1]
 
  */
@@ -22,6 +29,14 @@ func (a Array[T]) Find(f func(T) bool) T {
 	return Find(a, f)
 }
 
+func (a Array[T]) FindOk(f func(T) bool) (T, bool) {
+	return FindOk(a, f)
+}
+
+func (a Array[T]) FindOption(f func(T) bool) Option[T] {
+	return FindOption(a, f)
+}
+
 func (a Array[T]) Map(f func(T) T) Array[T] {
 	return Map(a, f)
 }
@@ -34,6 +49,11 @@ func (a Array[T]) Reduce(f func(T, T) T) T {
 	return Reduce(a, f)
 }
 
+// ReduceOption is Reduce that returns None for an empty array instead of panicking.
+func (a Array[T]) ReduceOption(f func(T, T) T) Option[T] {
+	return ReduceOption(a, f)
+}
+
 func (a Array[T]) Reverse() Array[T] {
 	return Reverse(a)
 }
@@ -54,14 +74,103 @@ func (a Array[T]) Shuffle() Array[T] {
 	return Shuffle(a)
 }
 
+func (a Array[T]) ShuffleWith(r *rand.Rand) Array[T] {
+	return ShuffleWith(a, r)
+}
+
+func (a Array[T]) Sample(n int, r *rand.Rand) Array[T] {
+	return Sample(a, n, r)
+}
+
+func (a Array[T]) SampleWithReplacement(n int, r *rand.Rand) Array[T] {
+	return SampleWithReplacement(a, n, r)
+}
+
+func (a Array[T]) WeightedSample(n int, weight func(T) float64, r *rand.Rand) Array[T] {
+	return WeightedSample(a, n, weight, r)
+}
+
+func (a Array[T]) ReservoirSample(n int, r *rand.Rand) Array[T] {
+	return ReservoirSample(slices.Values(a), n, r)
+}
+
 func (a Array[T]) Sort(f func(i, j int) bool) Array[T] {
 	return Sort(a, f)
 }
 
+func (a Array[T]) Join(sep string) string {
+	return Join(a, sep)
+}
+
+func (a Array[T]) Fill(start, end int, x T) Array[T] {
+	return Fill(a, start, end, x)
+}
+
+func (a Array[T]) Push(x ...T) Array[T] {
+	return Push(a, x...)
+}
+
+func (a Array[T]) Pop() (T, Array[T]) {
+	last, rest := Pop(a)
+	return last, rest
+}
+
+func (a Array[T]) Shift() (T, Array[T]) {
+	first, rest := Shift(a)
+	return first, rest
+}
+
+func (a Array[T]) Unshift(x ...T) Array[T] {
+	return Unshift(a, x...)
+}
+
+func (a Array[T]) Slice(start, end int) Array[T] {
+	return Slice(a, start, end)
+}
+
+func (a Array[T]) Splice(start, deleteCount int, items ...T) (Array[T], Array[T]) {
+	b, removed := Splice(a, start, deleteCount, items...)
+	return b, removed
+}
+
+func (a Array[T]) InsertAt(i int, items ...T) Array[T] {
+	return InsertAt(a, i, items...)
+}
+
+func (a Array[T]) RemoveAt(i int) Array[T] {
+	return RemoveAt(a, i)
+}
+
+func (a Array[T]) RemoveWhere(f func(T) bool) Array[T] {
+	return RemoveWhere(a, f)
+}
+
+func (a Array[T]) Union(b []T) Array[T] {
+	return Union(a, b)
+}
+
+// ForEach calls f for every element and returns the array, so the chain can go on.
+func (a Array[T]) ForEach(f func(T)) Array[T] {
+	ForEach(a, f)
+	return a
+}
+
+func (a Array[T]) Pluck(f func(T) float64) Numbers[float64] {
+	return Pluck(a, f)
+}
+
 func (a Array[T]) GroupBy(f func(T) string) map[string][]T {
 	return GroupBy(a, f)
 }
 
+func (a Array[T]) Groups(key func(T) string) []Group[string, T] {
+	return Groups(a, key)
+}
+
+func (a Array[T]) ToSetBy(key func(T) string) Set[string] {
+	return ToSetBy(a, key)
+}
+
 func (a Array[T]) GroupSumBy(key func(T) string, value func(T) float64) map[string]float64 {
 	return GroupSumBy(a, key, value)
 }
@@ -74,10 +183,86 @@ func (a Array[T]) GroupCountBy(key func(T) string) map[string]int {
 	return GroupCountBy(a, key)
 }
 
+func (a Array[T]) GroupReduceBy(key func(T) string, reduce func(float64, T) float64) map[string]float64 {
+	return GroupReduceBy(a, key, reduce)
+}
+
+func (a Array[T]) CounterBy(key func(T) string) Counter[string] {
+	return CounterBy(a, key)
+}
+
 func (a Array[T]) GroupStatsBy(key func(T) string, value func(T) float64) map[string]GroupStats[float64] {
 	return GroupStatsBy(a, key, value)
 }
 
+func (a Array[T]) GroupByTime(ts func(T) time.Time, bucket TimeBucket) map[time.Time][]T {
+	return GroupByTime(a, ts, bucket)
+}
+
+func (a Array[T]) GroupSumByTime(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) map[time.Time]float64 {
+	return GroupSumByTime(a, ts, value, bucket)
+}
+
+func (a Array[T]) GroupCountByTime(ts func(T) time.Time, bucket TimeBucket) map[time.Time]int {
+	return GroupCountByTime(a, ts, bucket)
+}
+
+func (a Array[T]) GroupStatsByTime(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) map[time.Time]GroupStats[float64] {
+	return GroupStatsByTime(a, ts, value, bucket)
+}
+
+func (a Array[T]) GroupByOrdered(f func(T) string) *OrderedMap[string, []T] {
+	return GroupByOrdered(a, f)
+}
+
+func (a Array[T]) GroupSumByOrdered(key func(T) string, value func(T) float64) *OrderedMap[string, float64] {
+	return GroupSumByOrdered(a, key, value)
+}
+
+func (a Array[T]) GroupSumByWhereOrdered(where func(T) bool, key func(T) string, value func(T) float64) *OrderedMap[string, float64] {
+	return GroupSumByWhereOrdered(a, where, key, value)
+}
+
+func (a Array[T]) GroupCountByOrdered(key func(T) string) *OrderedMap[string, int] {
+	return GroupCountByOrdered(a, key)
+}
+
+func (a Array[T]) GroupReduceByOrdered(key func(T) string, reduce func(float64, T) float64) *OrderedMap[string, float64] {
+	return GroupReduceByOrdered(a, key, reduce)
+}
+
+func (a Array[T]) GroupStatsByOrdered(key func(T) string, value func(T) float64) *OrderedMap[string, GroupStats[float64]] {
+	return GroupStatsByOrdered(a, key, value)
+}
+
+func (a Array[T]) IndexByOrdered(key func(T) string) *OrderedMap[string, T] {
+	return IndexByOrdered(a, key)
+}
+
+func (a Array[T]) GroupHyperLogLogByOrdered(group func(T) string, key func(T) string, precision uint8) *OrderedMap[string, *HyperLogLog[string]] {
+	return GroupHyperLogLogByOrdered(a, group, key, precision)
+}
+
+func (a Array[T]) GroupQuantileSketchByOrdered(key func(T) string, value func(T) float64, k int) *OrderedMap[string, *QuantileSketch[float64]] {
+	return GroupQuantileSketchByOrdered(a, key, value, k)
+}
+
+func (a Array[T]) GroupByTimeOrdered(ts func(T) time.Time, bucket TimeBucket) *OrderedMap[time.Time, []T] {
+	return GroupByTimeOrdered(a, ts, bucket)
+}
+
+func (a Array[T]) GroupSumByTimeOrdered(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) *OrderedMap[time.Time, float64] {
+	return GroupSumByTimeOrdered(a, ts, value, bucket)
+}
+
+func (a Array[T]) GroupCountByTimeOrdered(ts func(T) time.Time, bucket TimeBucket) *OrderedMap[time.Time, int] {
+	return GroupCountByTimeOrdered(a, ts, bucket)
+}
+
+func (a Array[T]) GroupStatsByTimeOrdered(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) *OrderedMap[time.Time, GroupStats[float64]] {
+	return GroupStatsByTimeOrdered(a, ts, value, bucket)
+}
+
 func (a Array[T]) DistinctBy(key func(T) string) Array[T] {
 	return DistinctBy(a, key)
 }
@@ -99,6 +284,129 @@ func (a Array[T]) SortByFloat64(key func(T) float64) Array[T] {
 	return SortBy(a, key)
 }
 
+func (a Array[T]) SortByKey(key Comparer[T]) Array[T] {
+	return sortByComparer(a, key)
+}
+
+func (a Array[T]) DistinctByKey(key Keyer[T]) Array[T] {
+	return key.DistinctBy(a)
+}
+
+// GroupByKey groups the items by key, in the order their key first appears.
+func (a Array[T]) GroupByKey(key Keyer[T]) []Group[any, T] {
+	return key.GroupsAny(a)
+}
+
+// IndexByKey keeps the last item of each key, like IndexBy.
+func (a Array[T]) IndexByKey(key Keyer[T]) map[any]T {
+	return key.IndexByAny(a)
+}
+
+func (a Array[T]) GroupCountByKey(key Keyer[T]) map[any]int {
+	return key.GroupCountByAny(a)
+}
+
+func (a Array[T]) IndexByKeyOrdered(key Keyer[T]) *OrderedMap[any, T] {
+	return key.IndexByAnyOrdered(a)
+}
+
+func (a Array[T]) GroupCountByKeyOrdered(key Keyer[T]) *OrderedMap[any, int] {
+	return key.GroupCountByAnyOrdered(a)
+}
+
+// Value stores the array as a JSON array; see SQLArray for other encodings.
+func (a Array[T]) Value() (driver.Value, error) {
+	return sqlValue(a, JSONEncoding)
+}
+
+func (a *Array[T]) Scan(src any) error {
+	w, err := sqlScan[T](src, JSONEncoding)
+	if err != nil {
+		return err
+	}
+	*a = w
+
+	return nil
+}
+
+// Vector copies the array into an immutable Vector.
+func (a Array[T]) Vector() Vector[T] {
+	return NewVector(a...)
+}
+
+// Deque copies the array into a Deque.
+func (a Array[T]) Deque() *Deque[T] {
+	return NewDeque(a...)
+}
+
+// Mutable starts the mutable chain on the same memory; see Mutable.
+func (a Array[T]) Mutable() Mutable[T] {
+	return Mutable[T](a)
+}
+
+// Heap copies the array into a Heap ordered by compare.
+func (a Array[T]) Heap(compare func(a, b T) int) *Heap[T] {
+	return NewHeap(compare, a...)
+}
+
+func (a Array[T]) TopK(k int, compare func(a, b T) int) Array[T] {
+	return TopK(a, k, compare)
+}
+
+func (a Array[T]) TopKBy(k int, key func(T) float64) Array[T] {
+	return TopKBy(a, k, key)
+}
+
+// MergeSorted merges the array with others; all of them must be sorted by compare.
+func (a Array[T]) MergeSorted(compare func(a, b T) int, others ...[]T) Array[T] {
+	return MergeSorted(compare, append([][]T{a}, others...)...)
+}
+
+func (a Array[T]) Schedule(workers int, cost func(T) float64) []Array[T] {
+	assigned := Schedule(a, workers, cost)
+	result := make([]Array[T], len(assigned))
+	for i, w := range assigned {
+		result[i] = w
+	}
+
+	return result
+}
+
+// SortedSlice copies the array into a SortedSlice ordered by key.
+func (a Array[T]) SortedSlice(key func(T) float64) *SortedSlice[T, float64] {
+	return NewSortedSlice(key, a...)
+}
+
+func (a Array[T]) BinarySearchBy(target float64, key func(T) float64) (int, bool) {
+	return BinarySearchBy(a, target, key)
+}
+
+func (a Array[T]) LowerBound(target float64, key func(T) float64) int {
+	return LowerBound(a, target, key)
+}
+
+func (a Array[T]) UpperBound(target float64, key func(T) float64) int {
+	return UpperBound(a, target, key)
+}
+
+func (a Array[T]) EqualRange(target float64, key func(T) float64) (int, int) {
+	return EqualRange(a, target, key)
+}
+
+func (a Array[T]) RangeBy(from, to float64, key func(T) float64) Array[T] {
+	return RangeBy(a, from, to, key)
+}
+
+// Cartesian returns every combination of one element of the array and one of each of others.
+func (a Array[T]) Cartesian(others ...[]T) Nested[T] {
+	return NestedOf(Cartesian(append([][]T{a}, others...)...)...)
+}
+
+// BuildTree links the rows into trees by their IDs; see the BuildTree function.
+func (a Array[T]) BuildTree(id func(T) string, parentID func(T) string) (Forest[T], TreeReport[T]) {
+	return BuildTree(a, id, parentID)
+}
+
 func (a Array[T]) Take(n int) Array[T] {
 	return Take(a, n)
 }
@@ -116,3 +424,75 @@ func (a Array[T]) Chunk(size int) []Array[T] {
 
 	return result
 }
+
+func (a Array[T]) RowNumber(partition func(T) string, order func(T) float64) []WindowRow[T, int] {
+	return RowNumber(a, partition, order)
+}
+
+func (a Array[T]) Rank(partition func(T) string, order func(T) float64) []WindowRow[T, int] {
+	return Rank(a, partition, order)
+}
+
+func (a Array[T]) DenseRank(partition func(T) string, order func(T) float64) []WindowRow[T, int] {
+	return DenseRank(a, partition, order)
+}
+
+func (a Array[T]) PercentRank(partition func(T) string, order func(T) float64) []WindowRow[T, float64] {
+	return PercentRank(a, partition, order)
+}
+
+func (a Array[T]) Lag(partition func(T) string, order func(T) float64, value func(T) float64, offset int, fallback float64) []WindowRow[T, float64] {
+	return Lag(a, partition, order, value, offset, fallback)
+}
+
+func (a Array[T]) Lead(partition func(T) string, order func(T) float64, value func(T) float64, offset int, fallback float64) []WindowRow[T, float64] {
+	return Lead(a, partition, order, value, offset, fallback)
+}
+
+func (a Array[T]) CumSum(partition func(T) string, order func(T) float64, value func(T) float64) []WindowRow[T, float64] {
+	return CumSum(a, partition, order, value)
+}
+
+func (a Array[T]) MovingAvg(partition func(T) string, order func(T) float64, value func(T) float64, n int) []WindowRow[T, float64] {
+	return MovingAvg(a, partition, order, value, n)
+}
+
+func (a Array[T]) ApproxCountDistinct(key func(T) string) uint64 {
+	return ApproxCountDistinct(a, key)
+}
+
+func (a Array[T]) ApproxQuantiles(value func(T) float64, qs ...float64) []float64 {
+	return ApproxQuantiles(a, value, qs...)
+}
+
+func (a Array[T]) GroupHyperLogLogBy(group func(T) string, key func(T) string, precision uint8) map[string]*HyperLogLog[string] {
+	return GroupHyperLogLogBy(a, group, key, precision)
+}
+
+func (a Array[T]) GroupQuantileSketchBy(key func(T) string, value func(T) float64, k int) map[string]*QuantileSketch[float64] {
+	return GroupQuantileSketchBy(a, key, value, k)
+}
+
+/* Go methods cannot declare type parameters, so the functions below bridge a
+* chain from Array[T] to Array[U] and let it keep going.
+* Example:
+*   totals := MapTo(Array[Order](orders).Filter(isPaid), func(o Order) float64 { return o.Total }).
+*       Filter(func(t float64) bool { return t > 100 }).
+*       Reverse()
+ */
+
+func MapTo[T, U any](a Array[T], f func(T) U) Array[U] {
+	return Map(a, f)
+}
+
+func FlatMapTo[T, U any](a Array[T], f func(T) []U) Array[U] {
+	return FlatMap(a, f)
+}
+
+func ZipTo[T, U, R any](a Array[T], b Array[U], f func(T, U) R) Array[R] {
+	return Zip(a, b, f)
+}
+
+func FoldTo[T, U any](a Array[T], init U, f func(U, T) U) U {
+	return Fold(a, init, f)
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/devalexandre/gofn/array"
 )
@@ -235,6 +236,16 @@ func TestArray(t *testing.T) {
 			t.Error("GroupCountBy failed. Got", count["Item 4"], "Expected", 3)
 		}
 
+		groups := itens.Groups(func(item Itens) string { return item.Name })
+		if len(groups) != 4 || groups[3].Key != "Item 4" || len(groups[3].Items) != 3 {
+			t.Error("Groups failed. Got", groups, "Expected four groups in input order")
+		}
+
+		counter := itens.CounterBy(func(item Itens) string { return item.Name })
+		if top := counter.MostCommon(1); top[0].Key != "Item 4" || top[0].Count != 3 {
+			t.Error("CounterBy failed. Got", top, "Expected Item 4 with 3 rows")
+		}
+
 		stats := itens.GroupStatsBy(
 			func(item Itens) string { return item.Name },
 			func(item Itens) float64 { return item.Price },
@@ -279,4 +290,173 @@ func TestArray(t *testing.T) {
 			t.Error("Chunk failed. Got", chunks, "Expected three chunks with two items")
 		}
 	})
+
+	t.Run("test time bucket chain methods", func(t *testing.T) {
+		type Sale struct {
+			At    time.Time
+			Total float64
+		}
+
+		sales := array.Array[Sale]{
+			{time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), 10},
+			{time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC), 20},
+			{time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), 5},
+		}
+		at := func(s Sale) time.Time { return s.At }
+		total := func(s Sale) float64 { return s.Total }
+		bucket := array.MonthBucket(time.UTC).FillGaps()
+		feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+		if grouped := sales.GroupByTime(at, bucket); len(grouped) != 3 || len(grouped[feb]) != 0 {
+			t.Error("GroupByTime failed. Got", grouped)
+		}
+		if sum := sales.GroupSumByTime(at, total, bucket); sum[time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)] != 30 {
+			t.Error("GroupSumByTime failed. Got", sum)
+		}
+		if count := sales.GroupCountByTime(at, bucket); count[feb] != 0 || len(count) != 3 {
+			t.Error("GroupCountByTime failed. Got", count)
+		}
+		if stats := sales.GroupStatsByTime(at, total, bucket); stats[time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)].Max != 5 {
+			t.Error("GroupStatsByTime failed. Got", stats)
+		}
+	})
+
+	t.Run("test type changing bridges", func(t *testing.T) {
+		type Order struct {
+			ID    int
+			Total float64
+			Tags  []string
+		}
+
+		orders := array.Array[Order]{
+			{1, 50, []string{"new"}},
+			{2, 150, []string{"vip", "new"}},
+			{3, 300, nil},
+		}
+
+		totals := array.MapTo(orders, func(o Order) float64 { return o.Total }).
+			Filter(func(t float64) bool { return t > 100 }).
+			Reverse()
+		if !reflect.DeepEqual(totals, array.Array[float64]{300, 150}) {
+			t.Error("MapTo failed. Got", totals, "Expected", array.Array[float64]{300, 150})
+		}
+
+		tags := array.FlatMapTo(orders, func(o Order) []string { return o.Tags }).Take(2)
+		if !reflect.DeepEqual(tags, array.Array[string]{"new", "vip"}) {
+			t.Error("FlatMapTo failed. Got", tags)
+		}
+
+		labels := array.ZipTo(orders, array.Array[string]{"a", "b"}, func(o Order, s string) string {
+			return fmt.Sprintf("%s%d", s, o.ID)
+		})
+		if !reflect.DeepEqual(labels, array.Array[string]{"a1", "b2"}) {
+			t.Error("ZipTo failed. Got", labels)
+		}
+
+		revenue := array.FoldTo(orders.Filter(func(o Order) bool { return o.Total > 100 }), 0.0, func(acc float64, o Order) float64 {
+			return acc + o.Total
+		})
+		if revenue != 450 {
+			t.Error("FoldTo failed. Got", revenue, "Expected", 450)
+		}
+	})
+
+	t.Run("test slice helper chain methods", func(t *testing.T) {
+		a := array.Array[int]{1, 2, 3}
+
+		if got, ok := a.FindOk(func(x int) bool { return x > 1 }); got != 2 || !ok {
+			t.Error("FindOk failed. Got", got, ok)
+		}
+		if got := a.Join("-"); got != "1-2-3" {
+			t.Error("Join failed. Got", got, "Expected", "1-2-3")
+		}
+		if got := a.Fill(0, 2, 9); !reflect.DeepEqual(got, array.Array[int]{9, 9, 3}) {
+			t.Error("Fill failed. Got", got)
+		}
+		if got := a.Union([]int{4}).Push(5).Unshift(0); !reflect.DeepEqual(got, array.Array[int]{0, 1, 2, 3, 4, 5}) {
+			t.Error("Union/Push/Unshift failed. Got", got)
+		}
+		if got := a.Slice(-2, 3).InsertAt(0, 7).RemoveAt(-1).RemoveWhere(func(x int) bool { return x == 7 }); !reflect.DeepEqual(got, array.Array[int]{2}) {
+			t.Error("Slice/InsertAt/RemoveAt/RemoveWhere failed. Got", got)
+		}
+		spliced, removed := a.Splice(0, 1, 5, 6)
+		if !reflect.DeepEqual(spliced, array.Array[int]{5, 6, 2, 3}) || !reflect.DeepEqual(removed, array.Array[int]{1}) {
+			t.Error("Splice failed. Got", spliced, removed)
+		}
+		last, rest := a.Pop()
+		if last != 3 || !reflect.DeepEqual(rest, array.Array[int]{1, 2}) {
+			t.Error("Pop failed. Got", last, rest)
+		}
+		first, rest := a.Shift()
+		if first != 1 || !reflect.DeepEqual(rest, array.Array[int]{2, 3}) {
+			t.Error("Shift failed. Got", first, rest)
+		}
+
+		sum := 0
+		doubled := a.ForEach(func(x int) { sum += x }).Map(func(x int) int { return x * 2 })
+		if sum != 6 || !reflect.DeepEqual(doubled, array.Array[int]{2, 4, 6}) {
+			t.Error("ForEach failed. Got", sum, doubled)
+		}
+		if got := a.Pluck(func(x int) float64 { return float64(x) / 2 }).Sum(); got != 3 {
+			t.Error("Pluck failed. Got", got, "Expected", 3)
+		}
+	})
+
+	t.Run("test group and window chain methods", func(t *testing.T) {
+		type Sale struct {
+			Category string
+			Month    float64
+			Total    float64
+		}
+
+		sales := array.Array[Sale]{
+			{"books", 2, 30},
+			{"games", 1, 50},
+			{"books", 1, 10},
+		}
+		category := func(s Sale) string { return s.Category }
+		month := func(s Sale) float64 { return s.Month }
+		total := func(s Sale) float64 { return s.Total }
+
+		reduced := sales.GroupReduceBy(category, func(acc float64, s Sale) float64 { return acc + s.Total })
+		if reduced["books"] != 40 {
+			t.Error("GroupReduceBy failed. Got", reduced)
+		}
+		if got := sales.RowNumber(category, month); got[0].Value != 2 || got[2].Value != 1 {
+			t.Error("RowNumber failed. Got", got)
+		}
+		if got := sales.Rank(func(Sale) string { return "" }, total); got[1].Value != 3 {
+			t.Error("Rank failed. Got", got)
+		}
+		if got := sales.DenseRank(category, total); got[0].Value != 2 {
+			t.Error("DenseRank failed. Got", got)
+		}
+		if got := sales.PercentRank(category, total); got[0].Value != 1 {
+			t.Error("PercentRank failed. Got", got)
+		}
+		if got := sales.Lag(category, month, total, 1, 0); got[0].Value != 10 {
+			t.Error("Lag failed. Got", got)
+		}
+		if got := sales.Lead(category, month, total, 1, 0); got[2].Value != 30 {
+			t.Error("Lead failed. Got", got)
+		}
+		if got := sales.CumSum(category, month, total); got[0].Value != 40 {
+			t.Error("CumSum failed. Got", got)
+		}
+		if got := sales.MovingAvg(category, month, total, 2); got[0].Value != 20 {
+			t.Error("MovingAvg failed. Got", got)
+		}
+		if got := sales.ApproxCountDistinct(category); got != 2 {
+			t.Error("ApproxCountDistinct failed. Got", got, "Expected", 2)
+		}
+		if got := sales.ApproxQuantiles(total, 0, 1); got[0] != 10 || got[1] != 50 {
+			t.Error("ApproxQuantiles failed. Got", got)
+		}
+		if got := sales.GroupHyperLogLogBy(category, func(s Sale) string { return fmt.Sprint(s.Month) }, 10); got["books"].Count() != 2 {
+			t.Error("GroupHyperLogLogBy failed. Got", got["books"].Count(), "Expected", 2)
+		}
+		if got := sales.GroupQuantileSketchBy(category, total, 200); got["games"].Quantile(0.5) != 50 {
+			t.Error("GroupQuantileSketchBy failed. Got", got["games"].Quantile(0.5), "Expected", 50)
+		}
+	})
 }
@@ -0,0 +1,524 @@
+package array
+
+import (
+	"database/sql/driver"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+/* ComparableArray is the chain for comparable values. It has every Array method,
+* returning ComparableArray instead of Array, plus the helpers that need
+* comparable, so chains over IDs and strings stay fluent.
+* Convert between the two with ComparableArray[T](a) and a.Array().
+* Example:
+*   ids := ComparableArray[int]{3, 1, 3, 2}.
+*       Unique().
+*       Difference([]int{2})
+*   fmt.Println(ids) // [3 1]
+ */
+type ComparableArray[T comparable] []T
+
+func (a ComparableArray[T]) Array() Array[T] {
+	return Array[T](a)
+}
+
+func (a ComparableArray[T]) Unique() ComparableArray[T] {
+	return Unique(a)
+}
+
+func (a ComparableArray[T]) Contains(x T) bool {
+	return Contains(a, x)
+}
+
+func (a ComparableArray[T]) IndexOf(x T) int {
+	return IndexOf(a, x)
+}
+
+func (a ComparableArray[T]) IndexOfOption(x T) Option[int] {
+	return IndexOfOption(a, x)
+}
+
+func (a ComparableArray[T]) Equals(b []T) bool {
+	return Equals(a, b)
+}
+
+func (a ComparableArray[T]) Intersect(b []T) ComparableArray[T] {
+	return Intersect(a, b)
+}
+
+func (a ComparableArray[T]) Difference(b []T) ComparableArray[T] {
+	return Difference(a, b)
+}
+
+func (a ComparableArray[T]) Count(x T) int {
+	return Count(a, x)
+}
+
+func (a ComparableArray[T]) Frequencies() map[T]int {
+	return Frequencies(a)
+}
+
+func (a ComparableArray[T]) ToSet() Set[T] {
+	return ToSet(a)
+}
+
+func (a ComparableArray[T]) Length() int {
+	return len(a)
+}
+
+func (a ComparableArray[T]) Filter(f func(T) bool) ComparableArray[T] {
+	return Filter(a, f)
+}
+
+func (a ComparableArray[T]) Find(f func(T) bool) T {
+	return Find(a, f)
+}
+
+func (a ComparableArray[T]) FindOk(f func(T) bool) (T, bool) {
+	return FindOk(a, f)
+}
+
+func (a ComparableArray[T]) FindOption(f func(T) bool) Option[T] {
+	return FindOption(a, f)
+}
+
+func (a ComparableArray[T]) Map(f func(T) T) ComparableArray[T] {
+	return Map(a, f)
+}
+
+func (a ComparableArray[T]) FlatMap(f func(T) []T) ComparableArray[T] {
+	return FlatMap(a, f)
+}
+
+func (a ComparableArray[T]) Reduce(f func(T, T) T) T {
+	return Reduce(a, f)
+}
+
+// ReduceOption is Reduce that returns None for an empty array instead of panicking.
+func (a ComparableArray[T]) ReduceOption(f func(T, T) T) Option[T] {
+	return ReduceOption(a, f)
+}
+
+func (a ComparableArray[T]) Reverse() ComparableArray[T] {
+	return Reverse(a)
+}
+
+func (a ComparableArray[T]) Any(f func(T) bool) bool {
+	return Any(a, f)
+}
+
+func (a ComparableArray[T]) Some(f func(T) bool) bool {
+	return Some(a, f)
+}
+
+func (a ComparableArray[T]) Every(f func(T) bool) bool {
+	return Every(a, f)
+}
+
+func (a ComparableArray[T]) Shuffle() ComparableArray[T] {
+	return Shuffle(a)
+}
+
+func (a ComparableArray[T]) ShuffleWith(r *rand.Rand) ComparableArray[T] {
+	return ShuffleWith(a, r)
+}
+
+func (a ComparableArray[T]) Sample(n int, r *rand.Rand) ComparableArray[T] {
+	return Sample(a, n, r)
+}
+
+func (a ComparableArray[T]) SampleWithReplacement(n int, r *rand.Rand) ComparableArray[T] {
+	return SampleWithReplacement(a, n, r)
+}
+
+func (a ComparableArray[T]) WeightedSample(n int, weight func(T) float64, r *rand.Rand) ComparableArray[T] {
+	return WeightedSample(a, n, weight, r)
+}
+
+func (a ComparableArray[T]) ReservoirSample(n int, r *rand.Rand) ComparableArray[T] {
+	return ReservoirSample(slices.Values(a), n, r)
+}
+
+func (a ComparableArray[T]) Sort(f func(i, j int) bool) ComparableArray[T] {
+	return Sort(a, f)
+}
+
+func (a ComparableArray[T]) Join(sep string) string {
+	return Join(a, sep)
+}
+
+func (a ComparableArray[T]) Fill(start, end int, x T) ComparableArray[T] {
+	return Fill(a, start, end, x)
+}
+
+func (a ComparableArray[T]) Push(x ...T) ComparableArray[T] {
+	return Push(a, x...)
+}
+
+func (a ComparableArray[T]) Pop() (T, ComparableArray[T]) {
+	last, rest := Pop(a)
+	return last, rest
+}
+
+func (a ComparableArray[T]) Shift() (T, ComparableArray[T]) {
+	first, rest := Shift(a)
+	return first, rest
+}
+
+func (a ComparableArray[T]) Unshift(x ...T) ComparableArray[T] {
+	return Unshift(a, x...)
+}
+
+func (a ComparableArray[T]) Slice(start, end int) ComparableArray[T] {
+	return Slice(a, start, end)
+}
+
+func (a ComparableArray[T]) Splice(start, deleteCount int, items ...T) (ComparableArray[T], ComparableArray[T]) {
+	b, removed := Splice(a, start, deleteCount, items...)
+	return b, removed
+}
+
+func (a ComparableArray[T]) InsertAt(i int, items ...T) ComparableArray[T] {
+	return InsertAt(a, i, items...)
+}
+
+func (a ComparableArray[T]) RemoveAt(i int) ComparableArray[T] {
+	return RemoveAt(a, i)
+}
+
+func (a ComparableArray[T]) RemoveWhere(f func(T) bool) ComparableArray[T] {
+	return RemoveWhere(a, f)
+}
+
+func (a ComparableArray[T]) Union(b []T) ComparableArray[T] {
+	return Union(a, b)
+}
+
+// ForEach calls f for every element and returns the array, so the chain can go on.
+func (a ComparableArray[T]) ForEach(f func(T)) ComparableArray[T] {
+	ForEach(a, f)
+	return a
+}
+
+func (a ComparableArray[T]) Pluck(f func(T) float64) Numbers[float64] {
+	return Pluck(a, f)
+}
+
+func (a ComparableArray[T]) GroupBy(f func(T) string) map[string][]T {
+	return GroupBy(a, f)
+}
+
+func (a ComparableArray[T]) Groups(key func(T) string) []Group[string, T] {
+	return Groups(a, key)
+}
+
+func (a ComparableArray[T]) ToSetBy(key func(T) string) Set[string] {
+	return ToSetBy(a, key)
+}
+
+func (a ComparableArray[T]) GroupSumBy(key func(T) string, value func(T) float64) map[string]float64 {
+	return GroupSumBy(a, key, value)
+}
+
+func (a ComparableArray[T]) GroupSumByWhere(where func(T) bool, key func(T) string, value func(T) float64) map[string]float64 {
+	return GroupSumByWhere(a, where, key, value)
+}
+
+func (a ComparableArray[T]) GroupCountBy(key func(T) string) map[string]int {
+	return GroupCountBy(a, key)
+}
+
+func (a ComparableArray[T]) GroupReduceBy(key func(T) string, reduce func(float64, T) float64) map[string]float64 {
+	return GroupReduceBy(a, key, reduce)
+}
+
+func (a ComparableArray[T]) CounterBy(key func(T) string) Counter[string] {
+	return CounterBy(a, key)
+}
+
+func (a ComparableArray[T]) GroupStatsBy(key func(T) string, value func(T) float64) map[string]GroupStats[float64] {
+	return GroupStatsBy(a, key, value)
+}
+
+func (a ComparableArray[T]) GroupByTime(ts func(T) time.Time, bucket TimeBucket) map[time.Time][]T {
+	return GroupByTime(a, ts, bucket)
+}
+
+func (a ComparableArray[T]) GroupSumByTime(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) map[time.Time]float64 {
+	return GroupSumByTime(a, ts, value, bucket)
+}
+
+func (a ComparableArray[T]) GroupCountByTime(ts func(T) time.Time, bucket TimeBucket) map[time.Time]int {
+	return GroupCountByTime(a, ts, bucket)
+}
+
+func (a ComparableArray[T]) GroupStatsByTime(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) map[time.Time]GroupStats[float64] {
+	return GroupStatsByTime(a, ts, value, bucket)
+}
+
+func (a ComparableArray[T]) FrequenciesOrdered() *OrderedMap[T, int] {
+	return FrequenciesOrdered(a)
+}
+
+func (a ComparableArray[T]) GroupByOrdered(f func(T) string) *OrderedMap[string, []T] {
+	return GroupByOrdered(a, f)
+}
+
+func (a ComparableArray[T]) GroupSumByOrdered(key func(T) string, value func(T) float64) *OrderedMap[string, float64] {
+	return GroupSumByOrdered(a, key, value)
+}
+
+func (a ComparableArray[T]) GroupSumByWhereOrdered(where func(T) bool, key func(T) string, value func(T) float64) *OrderedMap[string, float64] {
+	return GroupSumByWhereOrdered(a, where, key, value)
+}
+
+func (a ComparableArray[T]) GroupCountByOrdered(key func(T) string) *OrderedMap[string, int] {
+	return GroupCountByOrdered(a, key)
+}
+
+func (a ComparableArray[T]) GroupReduceByOrdered(key func(T) string, reduce func(float64, T) float64) *OrderedMap[string, float64] {
+	return GroupReduceByOrdered(a, key, reduce)
+}
+
+func (a ComparableArray[T]) GroupStatsByOrdered(key func(T) string, value func(T) float64) *OrderedMap[string, GroupStats[float64]] {
+	return GroupStatsByOrdered(a, key, value)
+}
+
+func (a ComparableArray[T]) IndexByOrdered(key func(T) string) *OrderedMap[string, T] {
+	return IndexByOrdered(a, key)
+}
+
+func (a ComparableArray[T]) GroupHyperLogLogByOrdered(group func(T) string, key func(T) string, precision uint8) *OrderedMap[string, *HyperLogLog[string]] {
+	return GroupHyperLogLogByOrdered(a, group, key, precision)
+}
+
+func (a ComparableArray[T]) GroupQuantileSketchByOrdered(key func(T) string, value func(T) float64, k int) *OrderedMap[string, *QuantileSketch[float64]] {
+	return GroupQuantileSketchByOrdered(a, key, value, k)
+}
+
+func (a ComparableArray[T]) GroupByTimeOrdered(ts func(T) time.Time, bucket TimeBucket) *OrderedMap[time.Time, []T] {
+	return GroupByTimeOrdered(a, ts, bucket)
+}
+
+func (a ComparableArray[T]) GroupSumByTimeOrdered(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) *OrderedMap[time.Time, float64] {
+	return GroupSumByTimeOrdered(a, ts, value, bucket)
+}
+
+func (a ComparableArray[T]) GroupCountByTimeOrdered(ts func(T) time.Time, bucket TimeBucket) *OrderedMap[time.Time, int] {
+	return GroupCountByTimeOrdered(a, ts, bucket)
+}
+
+func (a ComparableArray[T]) GroupStatsByTimeOrdered(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) *OrderedMap[time.Time, GroupStats[float64]] {
+	return GroupStatsByTimeOrdered(a, ts, value, bucket)
+}
+
+func (a ComparableArray[T]) DistinctBy(key func(T) string) ComparableArray[T] {
+	return DistinctBy(a, key)
+}
+
+func (a ComparableArray[T]) IndexBy(key func(T) string) map[string]T {
+	return IndexBy(a, key)
+}
+
+func (a ComparableArray[T]) Partition(f func(T) bool) (ComparableArray[T], ComparableArray[T]) {
+	matched, unmatched := Partition(a, f)
+	return matched, unmatched
+}
+
+func (a ComparableArray[T]) SortByString(key func(T) string) ComparableArray[T] {
+	return SortBy(a, key)
+}
+
+func (a ComparableArray[T]) SortByFloat64(key func(T) float64) ComparableArray[T] {
+	return SortBy(a, key)
+}
+
+func (a ComparableArray[T]) SortByKey(key Comparer[T]) ComparableArray[T] {
+	return sortByComparer(a, key)
+}
+
+func (a ComparableArray[T]) DistinctByKey(key Keyer[T]) ComparableArray[T] {
+	return key.DistinctBy(a)
+}
+
+// GroupByKey groups the items by key, in the order their key first appears.
+func (a ComparableArray[T]) GroupByKey(key Keyer[T]) []Group[any, T] {
+	return key.GroupsAny(a)
+}
+
+// IndexByKey keeps the last item of each key, like IndexBy.
+func (a ComparableArray[T]) IndexByKey(key Keyer[T]) map[any]T {
+	return key.IndexByAny(a)
+}
+
+func (a ComparableArray[T]) GroupCountByKey(key Keyer[T]) map[any]int {
+	return key.GroupCountByAny(a)
+}
+
+func (a ComparableArray[T]) IndexByKeyOrdered(key Keyer[T]) *OrderedMap[any, T] {
+	return key.IndexByAnyOrdered(a)
+}
+
+func (a ComparableArray[T]) GroupCountByKeyOrdered(key Keyer[T]) *OrderedMap[any, int] {
+	return key.GroupCountByAnyOrdered(a)
+}
+
+// Value stores the array as a JSON array; see SQLArray for other encodings.
+func (a ComparableArray[T]) Value() (driver.Value, error) {
+	return sqlValue(a, JSONEncoding)
+}
+
+func (a *ComparableArray[T]) Scan(src any) error {
+	w, err := sqlScan[T](src, JSONEncoding)
+	if err != nil {
+		return err
+	}
+	*a = w
+
+	return nil
+}
+
+// Vector copies the values into an immutable Vector.
+func (a ComparableArray[T]) Vector() Vector[T] {
+	return NewVector(a...)
+}
+
+// Deque copies the values into a Deque.
+func (a ComparableArray[T]) Deque() *Deque[T] {
+	return NewDeque(a...)
+}
+
+// Mutable starts the mutable chain on the same memory; see Mutable.
+func (a ComparableArray[T]) Mutable() MutableComparable[T] {
+	return MutableComparable[T](a)
+}
+
+// Heap copies the values into a Heap ordered by compare.
+func (a ComparableArray[T]) Heap(compare func(a, b T) int) *Heap[T] {
+	return NewHeap(compare, a...)
+}
+
+func (a ComparableArray[T]) TopK(k int, compare func(a, b T) int) ComparableArray[T] {
+	return TopK(a, k, compare)
+}
+
+func (a ComparableArray[T]) TopKBy(k int, key func(T) float64) ComparableArray[T] {
+	return TopKBy(a, k, key)
+}
+
+// MergeSorted merges the values with others; all of them must be sorted by compare.
+func (a ComparableArray[T]) MergeSorted(compare func(a, b T) int, others ...[]T) ComparableArray[T] {
+	return MergeSorted(compare, append([][]T{a}, others...)...)
+}
+
+func (a ComparableArray[T]) Schedule(workers int, cost func(T) float64) []ComparableArray[T] {
+	assigned := Schedule(a, workers, cost)
+	result := make([]ComparableArray[T], len(assigned))
+	for i, w := range assigned {
+		result[i] = w
+	}
+
+	return result
+}
+
+// SortedSlice copies the values into a SortedSlice ordered by key.
+func (a ComparableArray[T]) SortedSlice(key func(T) float64) *SortedSlice[T, float64] {
+	return NewSortedSlice(key, a...)
+}
+
+func (a ComparableArray[T]) BinarySearchBy(target float64, key func(T) float64) (int, bool) {
+	return BinarySearchBy(a, target, key)
+}
+
+func (a ComparableArray[T]) LowerBound(target float64, key func(T) float64) int {
+	return LowerBound(a, target, key)
+}
+
+func (a ComparableArray[T]) UpperBound(target float64, key func(T) float64) int {
+	return UpperBound(a, target, key)
+}
+
+func (a ComparableArray[T]) EqualRange(target float64, key func(T) float64) (int, int) {
+	return EqualRange(a, target, key)
+}
+
+func (a ComparableArray[T]) RangeBy(from, to float64, key func(T) float64) ComparableArray[T] {
+	return RangeBy(a, from, to, key)
+}
+
+// Cartesian returns every combination of one element of the values and one of each of others.
+func (a ComparableArray[T]) Cartesian(others ...[]T) Nested[T] {
+	return NestedOf(Cartesian(append([][]T{a}, others...)...)...)
+}
+
+// BuildTree links the values into trees by their IDs; see the BuildTree function.
+func (a ComparableArray[T]) BuildTree(id func(T) string, parentID func(T) string) (Forest[T], TreeReport[T]) {
+	return BuildTree(a, id, parentID)
+}
+
+func (a ComparableArray[T]) Take(n int) ComparableArray[T] {
+	return Take(a, n)
+}
+
+func (a ComparableArray[T]) Skip(n int) ComparableArray[T] {
+	return Skip(a, n)
+}
+
+func (a ComparableArray[T]) Chunk(size int) []ComparableArray[T] {
+	chunks := Chunk(a, size)
+	result := make([]ComparableArray[T], len(chunks))
+	for i, chunk := range chunks {
+		result[i] = chunk
+	}
+
+	return result
+}
+
+func (a ComparableArray[T]) RowNumber(partition func(T) string, order func(T) float64) []WindowRow[T, int] {
+	return RowNumber(a, partition, order)
+}
+
+func (a ComparableArray[T]) Rank(partition func(T) string, order func(T) float64) []WindowRow[T, int] {
+	return Rank(a, partition, order)
+}
+
+func (a ComparableArray[T]) DenseRank(partition func(T) string, order func(T) float64) []WindowRow[T, int] {
+	return DenseRank(a, partition, order)
+}
+
+func (a ComparableArray[T]) PercentRank(partition func(T) string, order func(T) float64) []WindowRow[T, float64] {
+	return PercentRank(a, partition, order)
+}
+
+func (a ComparableArray[T]) Lag(partition func(T) string, order func(T) float64, value func(T) float64, offset int, fallback float64) []WindowRow[T, float64] {
+	return Lag(a, partition, order, value, offset, fallback)
+}
+
+func (a ComparableArray[T]) Lead(partition func(T) string, order func(T) float64, value func(T) float64, offset int, fallback float64) []WindowRow[T, float64] {
+	return Lead(a, partition, order, value, offset, fallback)
+}
+
+func (a ComparableArray[T]) CumSum(partition func(T) string, order func(T) float64, value func(T) float64) []WindowRow[T, float64] {
+	return CumSum(a, partition, order, value)
+}
+
+func (a ComparableArray[T]) MovingAvg(partition func(T) string, order func(T) float64, value func(T) float64, n int) []WindowRow[T, float64] {
+	return MovingAvg(a, partition, order, value, n)
+}
+
+func (a ComparableArray[T]) ApproxCountDistinct(key func(T) string) uint64 {
+	return ApproxCountDistinct(a, key)
+}
+
+func (a ComparableArray[T]) ApproxQuantiles(value func(T) float64, qs ...float64) []float64 {
+	return ApproxQuantiles(a, value, qs...)
+}
+
+func (a ComparableArray[T]) GroupHyperLogLogBy(group func(T) string, key func(T) string, precision uint8) map[string]*HyperLogLog[string] {
+	return GroupHyperLogLogBy(a, group, key, precision)
+}
+
+func (a ComparableArray[T]) GroupQuantileSketchBy(key func(T) string, value func(T) float64, k int) map[string]*QuantileSketch[float64] {
+	return GroupQuantileSketchBy(a, key, value, k)
+}
@@ -0,0 +1,60 @@
+package array_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestComparableArray(t *testing.T) {
+	ids := array.ComparableArray[int]{3, 1, 3, 2, 5}
+
+	t.Run("test comparable methods", func(t *testing.T) {
+		if got := ids.Unique(); !reflect.DeepEqual(got, array.ComparableArray[int]{3, 1, 2, 5}) {
+			t.Error("Unique failed. Got", got)
+		}
+		if !ids.Contains(2) || ids.Contains(4) {
+			t.Error("Contains failed. Got", ids.Contains(2), ids.Contains(4))
+		}
+		if ids.IndexOf(2) != 3 || ids.IndexOf(4) != -1 {
+			t.Error("IndexOf failed. Got", ids.IndexOf(2), ids.IndexOf(4))
+		}
+		if !ids.Equals([]int{3, 1, 3, 2, 5}) || ids.Equals([]int{3}) {
+			t.Error("Equals failed")
+		}
+		if got := ids.Intersect([]int{5, 3}); !reflect.DeepEqual(got, array.ComparableArray[int]{3, 5}) {
+			t.Error("Intersect failed. Got", got)
+		}
+		if got := ids.Difference([]int{5, 3}); !reflect.DeepEqual(got, array.ComparableArray[int]{1, 2}) {
+			t.Error("Difference failed. Got", got)
+		}
+		if ids.Count(3) != 2 {
+			t.Error("Count failed. Got", ids.Count(3), "Expected", 2)
+		}
+		if got := ids.Frequencies(); got[3] != 2 || got[5] != 1 {
+			t.Error("Frequencies failed. Got", got)
+		}
+	})
+
+	t.Run("test chain stays comparable", func(t *testing.T) {
+		names := array.ComparableArray[string]{"bob", "Ann", "bob", "Cid"}
+		got := names.
+			Map(strings.ToLower).
+			Filter(func(s string) bool { return s != "cid" }).
+			Unique().
+			Reverse()
+		if !got.Equals([]string{"ann", "bob"}) {
+			t.Error("chain failed. Got", got)
+		}
+
+		if arr := got.Array(); !reflect.DeepEqual(arr, array.Array[string]{"ann", "bob"}) {
+			t.Error("Array failed. Got", arr)
+		}
+		back := array.ComparableArray[string](array.Array[string]{"x", "x"})
+		if back.Unique().Length() != 1 {
+			t.Error("conversion failed. Got", back.Unique())
+		}
+	})
+}
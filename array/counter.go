@@ -0,0 +1,141 @@
+package array
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"slices"
+	"strconv"
+)
+
+/* Counter counts occurrences of keys. It is a map, so the result of
+* GroupCountBy converts directly: Counter[string](GroupCountBy(w, key)).
+* Ties are always broken by key in ascending order, which keeps MostCommon and
+* the JSON encoding deterministic.
+* Example:
+*   c := NewCounter([]string{"a", "b", "a", "c", "a", "b"})
+*   fmt.Println(c.MostCommon(2)) // [{a 3} {b 2}]
+ */
+type Counter[K cmp.Ordered] map[K]int
+
+// CounterEntry is a key and its count, as returned by Counter.MostCommon.
+type CounterEntry[K cmp.Ordered] struct {
+	Key   K
+	Count int
+}
+
+func NewCounter[K cmp.Ordered](keys []K) Counter[K] {
+	c := make(Counter[K], len(keys))
+	for _, k := range keys {
+		c[k]++
+	}
+
+	return c
+}
+
+func CounterBy[T any, K cmp.Ordered](w []T, key func(T) K) Counter[K] {
+	return Counter[K](GroupCountBy(w, key))
+}
+
+// Add increases the count of k by n.
+func (c Counter[K]) Add(k K, n int) {
+	c[k] += n
+}
+
+// Subtract decreases the count of k by n; keys that drop to zero or below are removed.
+func (c Counter[K]) Subtract(k K, n int) {
+	c[k] -= n
+	if c[k] <= 0 {
+		delete(c, k)
+	}
+}
+
+// Merge adds the counts of others into c.
+func (c Counter[K]) Merge(others ...Counter[K]) Counter[K] {
+	for _, other := range others {
+		for k, n := range other {
+			c[k] += n
+		}
+	}
+
+	return c
+}
+
+func (c Counter[K]) Total() int {
+	total := 0
+	for _, n := range c {
+		total += n
+	}
+
+	return total
+}
+
+// Normalize returns the frequency of each key, so that the values sum to 1.
+func (c Counter[K]) Normalize() map[K]float64 {
+	total := float64(c.Total())
+	m := make(map[K]float64, len(c))
+	for k, n := range c {
+		m[k] = float64(n) / total
+	}
+
+	return m
+}
+
+// MostCommon returns the n keys with the highest counts, or every key when n <= 0.
+func (c Counter[K]) MostCommon(n int) []CounterEntry[K] {
+	entries := make([]CounterEntry[K], 0, len(c))
+	for k, count := range c {
+		entries = append(entries, CounterEntry[K]{Key: k, Count: count})
+	}
+	slices.SortFunc(entries, func(a, b CounterEntry[K]) int {
+		if a.Count != b.Count {
+			return cmp.Compare(b.Count, a.Count)
+		}
+		return cmp.Compare(a.Key, b.Key)
+	})
+	if n > 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+
+	return entries
+}
+
+// MarshalJSON encodes the counter as a JSON object in MostCommon order.
+func (c Counter[K]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, e := range c.MostCommon(0) {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := marshalJSONKey(e.Key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.WriteString(strconv.Itoa(e.Count))
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func (c *Counter[K]) UnmarshalJSON(data []byte) error {
+	var raw map[string]int
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	m := make(Counter[K], len(raw))
+	for s, n := range raw {
+		k, err := unmarshalJSONKey[K](s)
+		if err != nil {
+			return err
+		}
+		m[k] += n
+	}
+	*c = m
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package array_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestCounter(t *testing.T) {
+	c := array.NewCounter([]string{"b", "a", "c", "a", "b", "a", "d"})
+
+	t.Run("MostCommon", func(t *testing.T) {
+		expected := []array.CounterEntry[string]{{"a", 3}, {"b", 2}, {"c", 1}}
+		if got := c.MostCommon(3); !reflect.DeepEqual(got, expected) {
+			t.Error("MostCommon failed. Got", got, "Expected", expected)
+		}
+		if got := c.MostCommon(0); len(got) != 4 || got[3].Key != "d" {
+			t.Error("MostCommon failed. Got", got, "Expected every key")
+		}
+	})
+
+	t.Run("Total and Normalize", func(t *testing.T) {
+		if c.Total() != 7 {
+			t.Error("Total failed. Got", c.Total(), "Expected", 7)
+		}
+		freq := array.NewCounter([]int{1, 1, 2, 3}).Normalize()
+		if !reflect.DeepEqual(freq, map[int]float64{1: 0.5, 2: 0.25, 3: 0.25}) {
+			t.Error("Normalize failed. Got", freq)
+		}
+	})
+
+	t.Run("Add Subtract Merge", func(t *testing.T) {
+		a := array.NewCounter([]string{"x", "y"})
+		a.Add("x", 2)
+		a.Subtract("y", 1)
+		if !reflect.DeepEqual(a, array.Counter[string]{"x": 3}) {
+			t.Error("Add/Subtract failed. Got", a)
+		}
+
+		b := array.Counter[string](array.GroupCountBy([]string{"x", "z"}, func(s string) string { return s }))
+		a.Merge(b, array.Counter[string]{"z": 4})
+		if !reflect.DeepEqual(a, array.Counter[string]{"x": 4, "z": 5}) {
+			t.Error("Merge failed. Got", a)
+		}
+	})
+
+	t.Run("CounterBy", func(t *testing.T) {
+		byLen := array.CounterBy([]string{"go", "js", "rust"}, func(s string) int { return len(s) })
+		if byLen[2] != 2 || byLen[4] != 1 {
+			t.Error("CounterBy failed. Got", byLen)
+		}
+	})
+}
+
+func TestCounterJSON(t *testing.T) {
+	c := array.NewCounter([]string{"b", "a", "b", "c"})
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"b":2,"a":1,"c":1}` {
+		t.Error("MarshalJSON failed. Got", string(data))
+	}
+
+	var decoded array.Counter[string]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, c) {
+		t.Error("UnmarshalJSON failed. Got", decoded, "Expected", c)
+	}
+
+	ints := array.NewCounter([]int{3, 3, 1})
+	data, err = json.Marshal(ints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"3":2,"1":1}` {
+		t.Error("MarshalJSON failed. Got", string(data))
+	}
+	var decodedInts array.Counter[int]
+	if err := json.Unmarshal(data, &decodedInts); err != nil || !reflect.DeepEqual(decodedInts, ints) {
+		t.Error("UnmarshalJSON failed. Got", decodedInts, err)
+	}
+
+	control := array.NewCounter([]string{"a\x01b", "x\vy", "q\"t"})
+	data, err = json.Marshal(control)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decodedControl array.Counter[string]
+	if err := json.Unmarshal(data, &decodedControl); err != nil || !reflect.DeepEqual(decodedControl, control) {
+		t.Error("UnmarshalJSON failed on control characters. Got", decodedControl, err)
+	}
+}
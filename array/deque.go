@@ -0,0 +1,205 @@
+package array
+
+import "iter"
+
+/* Deque is a double-ended queue backed by a growable ring buffer. Push, Pop,
+* Shift and Unshift are O(1) amortized, and At and Set are O(1), so it can be
+* used as a queue, a stack or both without the copies made by the slice
+* functions of the same names. The zero value is an empty deque ready to use.
+* Example:
+*   q := NewDeque(1, 2)
+*   q.Push(3)
+*   q.Unshift(0)
+*   first := q.Shift() // 0
+*   last := q.Pop()    // 3
+*   fmt.Println(first, last, q.Slice()) // 0 3 [1 2]
+ */
+type Deque[T any] struct {
+	buf   []T
+	head  int
+	n     int
+	bound int
+}
+
+func NewDeque[T any](items ...T) *Deque[T] {
+	d := &Deque[T]{}
+	d.Push(items...)
+	return d
+}
+
+/* NewBoundedDeque returns a deque that keeps at most n elements. When it is
+* full, Push drops the first element and Unshift drops the last one, so a deque
+* fed with Push holds the last n elements seen. It panics if n is not positive.
+* Example:
+*   last := NewBoundedDeque[int](3)
+*   last.Push(1, 2, 3, 4, 5)
+*   fmt.Println(last.Slice()) // [3 4 5]
+ */
+func NewBoundedDeque[T any](n int) *Deque[T] {
+	if n <= 0 {
+		panic("bound must be positive")
+	}
+	return &Deque[T]{buf: make([]T, n), bound: n}
+}
+
+func (d *Deque[T]) Len() int {
+	return d.n
+}
+
+// Bound returns the maximum number of elements of a bounded deque, or 0 if the
+// deque is unbounded.
+func (d *Deque[T]) Bound() int {
+	return d.bound
+}
+
+// At returns the element at index i. It panics if i is out of range.
+func (d *Deque[T]) At(i int) T {
+	d.checkIndex(i)
+	return d.buf[d.pos(i)]
+}
+
+// Set replaces the element at index i. It panics if i is out of range.
+func (d *Deque[T]) Set(i int, x T) {
+	d.checkIndex(i)
+	d.buf[d.pos(i)] = x
+}
+
+// Push adds x at the end.
+func (d *Deque[T]) Push(x ...T) {
+	for _, e := range x {
+		if d.bound > 0 && d.n == d.bound {
+			d.buf[d.head] = e
+			d.head = d.pos(1)
+			continue
+		}
+		d.grow()
+		d.buf[d.pos(d.n)] = e
+		d.n++
+	}
+}
+
+// Unshift adds x at the beginning, in the order given.
+func (d *Deque[T]) Unshift(x ...T) {
+	for i := len(x) - 1; i >= 0; i-- {
+		if d.bound > 0 && d.n == d.bound {
+			d.head = d.pos(d.n - 1)
+			d.buf[d.head] = x[i]
+			continue
+		}
+		d.grow()
+		d.head = d.pos(len(d.buf) - 1)
+		d.buf[d.head] = x[i]
+		d.n++
+	}
+}
+
+// Pop removes and returns the last element. It panics if the deque is empty.
+func (d *Deque[T]) Pop() T {
+	if d.n == 0 {
+		panic("cannot Pop an empty deque")
+	}
+	var zero T
+	i := d.pos(d.n - 1)
+	x := d.buf[i]
+	d.buf[i] = zero
+	d.n--
+	return x
+}
+
+// Shift removes and returns the first element. It panics if the deque is empty.
+func (d *Deque[T]) Shift() T {
+	if d.n == 0 {
+		panic("cannot Shift an empty deque")
+	}
+	var zero T
+	x := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.pos(1)
+	d.n--
+	return x
+}
+
+// Front returns the first element; ok is false when the deque is empty.
+func (d *Deque[T]) Front() (x T, ok bool) {
+	if d.n == 0 {
+		return x, false
+	}
+	return d.buf[d.head], true
+}
+
+// Back returns the last element; ok is false when the deque is empty.
+func (d *Deque[T]) Back() (x T, ok bool) {
+	if d.n == 0 {
+		return x, false
+	}
+	return d.buf[d.pos(d.n-1)], true
+}
+
+// Clear removes every element and keeps the allocated buffer.
+func (d *Deque[T]) Clear() {
+	clear(d.buf)
+	d.head, d.n = 0, 0
+}
+
+// All iterates over the indexes and elements from front to back.
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < d.n; i++ {
+			if !yield(i, d.buf[d.pos(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values iterates over the elements from front to back.
+func (d *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.n; i++ {
+			if !yield(d.buf[d.pos(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Slice copies the elements into a new slice, from front to back.
+func (d *Deque[T]) Slice() []T {
+	w := make([]T, d.n)
+	if d.n == 0 {
+		return w
+	}
+	k := copy(w, d.buf[d.head:min(d.head+d.n, len(d.buf))])
+	copy(w[k:], d.buf[:d.n-k])
+
+	return w
+}
+
+func (d *Deque[T]) Array() Array[T] {
+	return d.Slice()
+}
+
+// pos returns the position in the buffer of index i.
+func (d *Deque[T]) pos(i int) int {
+	i += d.head
+	if i >= len(d.buf) {
+		i -= len(d.buf)
+	}
+	return i
+}
+
+// grow makes room for one more element.
+func (d *Deque[T]) grow() {
+	if d.n < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(8, 2*len(d.buf)))
+	copy(buf, d.Slice())
+	d.buf, d.head = buf, 0
+}
+
+func (d *Deque[T]) checkIndex(i int) {
+	if i < 0 || i >= d.n {
+		panic("deque index out of range")
+	}
+}
@@ -0,0 +1,120 @@
+package array_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestDeque(t *testing.T) {
+	t.Run("test both ends", func(t *testing.T) {
+		d := array.NewDeque(2, 3)
+		d.Push(4, 5)
+		d.Unshift(0, 1)
+		if got := d.Slice(); !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4, 5}) {
+			t.Error("Push and Unshift failed. Got", got)
+		}
+		if first, last := d.Shift(), d.Pop(); first != 0 || last != 5 {
+			t.Error("Shift and Pop failed. Got", first, last)
+		}
+		if x, ok := d.Front(); !ok || x != 1 {
+			t.Error("Front failed. Got", x, ok)
+		}
+		if x, ok := d.Back(); !ok || x != 4 {
+			t.Error("Back failed. Got", x, ok)
+		}
+		d.Set(0, 10)
+		if d.At(0) != 10 || d.Len() != 4 {
+			t.Error("Set failed. Got", d.Slice())
+		}
+	})
+
+	t.Run("test wrap around", func(t *testing.T) {
+		var d array.Deque[int]
+		var want []int
+		for i := range 100 {
+			switch i % 3 {
+			case 0:
+				d.Push(i)
+				want = append(want, i)
+			case 1:
+				d.Unshift(i)
+				want = append([]int{i}, want...)
+			default:
+				d.Shift()
+				want = want[1:]
+			}
+		}
+		if got := d.Slice(); !reflect.DeepEqual(got, want) {
+			t.Error("mixed operations failed. Got", got, "Expected", want)
+		}
+		if got := slices.Collect(d.Values()); !reflect.DeepEqual(got, want) {
+			t.Error("Values failed. Got", got, "Expected", want)
+		}
+		for i, x := range d.All() {
+			if x != want[i] {
+				t.Error("All failed at", i, "Got", x, "Expected", want[i])
+			}
+		}
+	})
+
+	t.Run("test bounded", func(t *testing.T) {
+		d := array.NewBoundedDeque[int](3)
+		d.Push(1, 2, 3, 4, 5)
+		if got := d.Slice(); !reflect.DeepEqual(got, []int{3, 4, 5}) {
+			t.Error("bounded Push failed. Got", got)
+		}
+		d.Unshift(0)
+		if got := d.Slice(); !reflect.DeepEqual(got, []int{0, 3, 4}) {
+			t.Error("bounded Unshift failed. Got", got)
+		}
+		if d.Bound() != 3 || d.Len() != 3 {
+			t.Error("Bound failed. Got", d.Bound(), d.Len())
+		}
+		d.Clear()
+		d.Push(7)
+		if got := d.Slice(); !reflect.DeepEqual(got, []int{7}) {
+			t.Error("Clear failed. Got", got)
+		}
+	})
+
+	t.Run("test constant time ends", func(t *testing.T) {
+		d := array.NewDeque[int]()
+		d.Push(make([]int, 1000)...)
+		allocs := testing.AllocsPerRun(100, func() {
+			d.Unshift(d.Pop())
+			d.Push(d.Shift())
+		})
+		if allocs != 0 {
+			t.Error("Deque allocated. Got", allocs, "Expected", 0)
+		}
+	})
+
+	t.Run("test panics", func(t *testing.T) {
+		for name, f := range map[string]func(){
+			"Pop":             func() { array.NewDeque[int]().Pop() },
+			"Shift":           func() { array.NewDeque[int]().Shift() },
+			"At":              func() { array.NewDeque(1).At(1) },
+			"NewBoundedDeque": func() { array.NewBoundedDeque[int](0) },
+		} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Error(name, "should panic")
+					}
+				}()
+				f()
+			}()
+		}
+	})
+
+	t.Run("test chain", func(t *testing.T) {
+		d := array.Numbers[int]{1, 2}.Deque()
+		d.Unshift(0)
+		if got := d.Array(); !reflect.DeepEqual(got, array.Array[int]{0, 1, 2}) {
+			t.Error("Deque chain failed. Got", got)
+		}
+	})
+}
@@ -55,6 +55,23 @@ func Find[T any](a []T, f func(T) bool) T {
 	return zero
 }
 
+/* FindOk is like Find, but also reports whether an element was found
+* Example:
+*   a := []int{1, 3, 5}
+*   b, ok := FindOk(a, func(x int) bool { return x%2 == 0 })
+*   fmt.Println(b, ok) // 0 false
+ */
+
+func FindOk[T any](a []T, f func(T) bool) (T, bool) {
+	for _, x := range a {
+		if f(x) {
+			return x, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
 /* Map
 * Example:
 *   a := []int{1, 2, 3, 4, 5}
@@ -111,6 +128,38 @@ func Reduce[T any](a []T, f func(T, T) T) T {
 	return x
 }
 
+/* Fold
+* Example:
+*   a := []int{1, 2, 3}
+*   b := Fold(a, "", func(acc string, x int) string { return acc + fmt.Sprint(x) })
+*   fmt.Println(b) // 123
+ */
+
+func Fold[T, A any](a []T, init A, f func(A, T) A) A {
+	acc := init
+	for _, x := range a {
+		acc = f(acc, x)
+	}
+	return acc
+}
+
+/* Zip combines the elements at the same index; the result is as long as the shorter slice
+* Example:
+*   a := []string{"a", "b", "c"}
+*   b := []int{1, 2}
+*   c := Zip(a, b, func(s string, n int) string { return s + fmt.Sprint(n) })
+*   fmt.Println(c) // [a1 b2]
+ */
+
+func Zip[T, U, R any](a []T, b []U, f func(T, U) R) []R {
+	n := min(len(a), len(b))
+	c := make([]R, n)
+	for i := 0; i < n; i++ {
+		c[i] = f(a[i], b[i])
+	}
+	return c
+}
+
 /* Any
 * Example:
 *   a := []int{1, 2, 3, 4, 5}
@@ -229,6 +278,30 @@ func Max[T Number](a []T) T {
 	return maximum
 }
 
+/* Avg array of numbers
+* Example:
+*   a := []int{1, 2, 3, 4}
+*   b := Avg(a)
+*   fmt.Println(b) // 2.5
+ */
+func Avg[T Number](a []T) float64 {
+	return float64(Sum(a)) / float64(len(a))
+}
+
+/* Stats array of numbers
+* Example:
+*   a := []int{1, 2, 3, 4}
+*   b := Stats(a)
+*   fmt.Println(b) // {4 10 1 4 2.5}
+ */
+func Stats[T Number](a []T) GroupStats[T] {
+	var stats GroupStats[T]
+	for _, x := range a {
+		stats.Add(x)
+	}
+	return stats
+}
+
 /* ForEach
 * Example:
 *   a := []int{1, 2, 3, 4, 5}
@@ -321,6 +394,63 @@ func Unique[T comparable](a []T) []T {
 	return b
 }
 
+/* Intersect returns the unique elements of a that are also in b, in the order of a
+* Example:
+*   a := []int{1, 2, 2, 3, 4}
+*   b := []int{2, 4, 6}
+*   c := Intersect(a, b)
+*   fmt.Println(c) // [2 4]
+ */
+
+func Intersect[T comparable](a, b []T) []T {
+	return Unique(Filter(a, ToSet(b).Has))
+}
+
+/* Difference returns the unique elements of a that are not in b, in the order of a
+* Example:
+*   a := []int{1, 2, 2, 3, 4}
+*   b := []int{2, 4, 6}
+*   c := Difference(a, b)
+*   fmt.Println(c) // [1 3]
+ */
+
+func Difference[T comparable](a, b []T) []T {
+	in := ToSet(b)
+	return Unique(Filter(a, func(x T) bool { return !in.Has(x) }))
+}
+
+/* Count returns how many times x appears in a
+* Example:
+*   a := []string{"a", "b", "a"}
+*   b := Count(a, "a")
+*   fmt.Println(b) // 2
+ */
+
+func Count[T comparable](a []T, x T) int {
+	n := 0
+	for _, y := range a {
+		if y == x {
+			n++
+		}
+	}
+	return n
+}
+
+/* Frequencies counts every element of a
+* Example:
+*   a := []string{"a", "b", "a"}
+*   b := Frequencies(a)
+*   fmt.Println(b) // map[a:2 b:1]
+ */
+
+func Frequencies[T comparable](a []T) map[T]int {
+	m := make(map[T]int)
+	for _, x := range a {
+		m[x]++
+	}
+	return m
+}
+
 /* Union
 * Example:
 *   a := []int{1, 2, 3, 4, 5}
@@ -341,15 +471,20 @@ func Union[T any](a, b []T) []T {
 }
 
 /* Fill
+* Like JavaScript, negative indexes count from the end and indexes out of range
+* are clamped, so Fill never panics.
 * Example:
 *   a := []int{1, 2, 3, 4, 5}
 *   b := Fill(a, 0, 3, 10)
 *   fmt.Println(b) // [10 10 10 4 5]
+*   c := Fill(a, -2, 99, 0)
+*   fmt.Println(c) // [1 2 3 0 0]
  */
 
 func Fill[T any](a []T, start, end int, x T) []T {
 	b := make([]T, len(a))
 	copy(b, a)
+	start, end = clampIndex(start, len(a)), clampIndex(end, len(a))
 	for i := start; i < end; i++ {
 		b[i] = x
 	}
@@ -371,14 +506,18 @@ func Join[T any](a []T, sep string) string {
 	return strings.Join(b, sep)
 }
 
-/* Pop removes and returns the last element of the slice
+/* Pop removes and returns the last element of the slice.
+* Push, Pop, Shift and Unshift never share memory with their arguments: the
+* returned slice is a new copy, so writing to it or appending to it never
+* changes the input, even when several goroutines start from the same slice.
+* Use Vector to avoid the copy.
 * Example:
 *   a := []int{1, 2, 3, 4, 5}
 *   b, c := Pop(a)
 *   fmt.Println(b, c) // 5 [1 2 3 4]
  */
 func Pop[T any](a []T) (T, []T) {
-	return a[len(a)-1], a[:len(a)-1]
+	return a[len(a)-1], slices.Clone(a[:len(a)-1])
 }
 
 /* Push adds one or more elements to the end of the slice
@@ -389,10 +528,12 @@ func Pop[T any](a []T) (T, []T) {
  */
 
 func Push[T any](a []T, x ...T) []T {
-	return append(a, x...)
+	b := make([]T, 0, len(a)+len(x))
+	return append(append(b, a...), x...)
 }
 
-/* Shift removes and returns the first element of the slice
+/* Shift removes and returns the first element of the slice.
+* It copies the slice on every call; use a Deque for queue-like use.
 * Example:
 *   a := []int{1, 2, 3, 4, 5}
 *   b, c := Shift(a)
@@ -400,7 +541,7 @@ func Push[T any](a []T, x ...T) []T {
  */
 
 func Shift[T any](a []T) (T, []T) {
-	return a[0], a[1:]
+	return a[0], slices.Clone(a[1:])
 }
 
 /* Sort sorts the slice in increasing order
@@ -417,7 +558,8 @@ func Sort[T any](a []T, less func(i, j int) bool) []T {
 	return b
 }
 
-/* Unshift adds one or more elements to the beginning of the slice
+/* Unshift adds one or more elements to the beginning of the slice.
+* It copies the slice on every call; use a Deque for queue-like use.
 * Example:
 *   a := []int{1, 2, 3, 4, 5}
 *   b := Unshift(a, 6, 7, 8, 9, 10)
@@ -425,7 +567,83 @@ func Sort[T any](a []T, less func(i, j int) bool) []T {
  */
 
 func Unshift[T any](a []T, x ...T) []T {
-	return append(x, a...)
+	b := make([]T, 0, len(a)+len(x))
+	return append(append(b, x...), a...)
+}
+
+// clampIndex resolves a JavaScript-style index: negative values count from the
+// end, and the result is clamped to [0, n].
+func clampIndex(i, n int) int {
+	if i < 0 {
+		i += n
+	}
+	return max(0, min(i, n))
+}
+
+/* Slice returns a copy of the elements from start up to, but not including, end.
+* Negative indexes count from the end and indexes out of range are clamped.
+* Example:
+*   a := []int{1, 2, 3, 4, 5}
+*   fmt.Println(Slice(a, 1, 3))   // [2 3]
+*   fmt.Println(Slice(a, -2, 99)) // [4 5]
+ */
+func Slice[T any](a []T, start, end int) []T {
+	start, end = clampIndex(start, len(a)), clampIndex(end, len(a))
+	if start >= end {
+		return []T{}
+	}
+	return slices.Clone(a[start:end])
+}
+
+/* Splice removes deleteCount elements at start and inserts items in their place.
+* It returns the new slice and the removed elements, and leaves the input unchanged.
+* Example:
+*   a := []int{1, 2, 3, 4, 5}
+*   b, removed := Splice(a, 1, 2, 8, 9, 10)
+*   fmt.Println(b, removed) // [1 8 9 10 4 5] [2 3]
+ */
+func Splice[T any](a []T, start, deleteCount int, items ...T) ([]T, []T) {
+	start = clampIndex(start, len(a))
+	end := start + max(0, min(deleteCount, len(a)-start))
+
+	b := make([]T, 0, len(a)-(end-start)+len(items))
+	b = append(b, a[:start]...)
+	b = append(b, items...)
+	b = append(b, a[end:]...)
+	return b, slices.Clone(a[start:end])
+}
+
+/* InsertAt inserts items before index i; a negative i counts from the end.
+* Example:
+*   a := []int{1, 2, 5}
+*   fmt.Println(InsertAt(a, 2, 3, 4)) // [1 2 3 4 5]
+ */
+func InsertAt[T any](a []T, i int, items ...T) []T {
+	b, _ := Splice(a, i, 0, items...)
+	return b
+}
+
+/* RemoveAt removes the element at index i; a negative i counts from the end.
+* An index out of range leaves the elements unchanged.
+* Example:
+*   a := []int{1, 2, 3}
+*   fmt.Println(RemoveAt(a, -1)) // [1 2]
+ */
+func RemoveAt[T any](a []T, i int) []T {
+	if i < -len(a) || i >= len(a) {
+		return slices.Clone(a)
+	}
+	b, _ := Splice(a, i, 1)
+	return b
+}
+
+/* RemoveWhere removes the elements that satisfy f; it is the opposite of Filter.
+* Example:
+*   a := []int{1, 2, 3, 4}
+*   fmt.Println(RemoveWhere(a, func(x int) bool { return x%2 == 0 })) // [1 3]
+ */
+func RemoveWhere[T any](a []T, f func(T) bool) []T {
+	return Filter(a, func(x T) bool { return !f(x) })
 }
 
 /* group w rows by key
@@ -461,6 +679,35 @@ func GroupBy[T any, K comparable](w []T, key func(T) K) map[K][]T {
 	return m
 }
 
+// Group is one group of rows sharing the same key.
+type Group[K comparable, T any] struct {
+	Key   K
+	Items []T
+}
+
+/* Groups groups rows by key like GroupBy, but returns the groups in the order
+* their key first appears, so the result is deterministic.
+* Example:
+*   groups := Groups([]int{1, 2, 3, 4}, func(x int) bool { return x%2 == 0 })
+*   fmt.Println(groups) // [{false [1 3]} {true [2 4]}]
+ */
+func Groups[T any, K comparable](w []T, key func(T) K) []Group[K, T] {
+	var groups []Group[K, T]
+	positions := make(map[K]int)
+	for _, x := range w {
+		k := key(x)
+		i, ok := positions[k]
+		if !ok {
+			i = len(groups)
+			positions[k] = i
+			groups = append(groups, Group[K, T]{Key: k})
+		}
+		groups[i].Items = append(groups[i].Items, x)
+	}
+
+	return groups
+}
+
 /* GroupSumBy groups rows by key and sums a numeric value for each group.
 * Example:
 *   totalByName := GroupSumBy(items,
@@ -512,29 +759,76 @@ func GroupStatsBy[T any, K comparable, V Number](w []T, key func(T) K, value fun
 	m := make(map[K]GroupStats[V])
 	for _, x := range w {
 		k := key(x)
-		v := value(x)
-		stats, ok := m[k]
-		if !ok {
-			m[k] = GroupStats[V]{
-				Count: 1,
-				Sum:   v,
-				Min:   v,
-				Max:   v,
-				Avg:   float64(v),
-			}
-			continue
-		}
+		stats := m[k]
+		stats.Add(value(x))
+		m[k] = stats
+	}
 
-		stats.Count++
-		stats.Sum += v
-		if v < stats.Min {
-			stats.Min = v
-		}
-		if v > stats.Max {
-			stats.Max = v
+	return m
+}
+
+/* Add adds one value to the stats. The zero GroupStats is ready to use.
+* Example:
+*   var stats GroupStats[int]
+*   stats.Add(3)
+*   stats.Add(5)
+*   fmt.Println(stats) // {2 8 3 5 4}
+ */
+func (s *GroupStats[V]) Add(v V) {
+	if s.Count == 0 {
+		*s = GroupStats[V]{Count: 1, Sum: v, Min: v, Max: v, Avg: float64(v)}
+		return
+	}
+
+	s.Count++
+	s.Sum += v
+	if v < s.Min {
+		s.Min = v
+	}
+	if v > s.Max {
+		s.Max = v
+	}
+	s.Avg = float64(s.Sum) / float64(s.Count)
+}
+
+// Merge combines the stats of another chunk into s, as if its values had been
+// added one by one. Float sums may differ in the last bits because the
+// additions happen in a different order.
+func (s *GroupStats[V]) Merge(other GroupStats[V]) {
+	if other.Count == 0 {
+		return
+	}
+	if s.Count == 0 {
+		*s = other
+		return
+	}
+
+	s.Count += other.Count
+	s.Sum += other.Sum
+	if other.Min < s.Min {
+		s.Min = other.Min
+	}
+	if other.Max > s.Max {
+		s.Max = other.Max
+	}
+	s.Avg = float64(s.Sum) / float64(s.Count)
+}
+
+/* MergeGroupStats combines the results of several GroupStatsBy calls, e.g. one
+* per file or shard, into a new map.
+* Example:
+*   first := GroupStatsBy(chunk1, key, value)
+*   second := GroupStatsBy(chunk2, key, value)
+*   total := MergeGroupStats(first, second)
+ */
+func MergeGroupStats[K comparable, V Number](maps ...map[K]GroupStats[V]) map[K]GroupStats[V] {
+	m := make(map[K]GroupStats[V])
+	for _, stats := range maps {
+		for k, s := range stats {
+			merged := m[k]
+			merged.Merge(s)
+			m[k] = merged
 		}
-		stats.Avg = float64(stats.Sum) / float64(stats.Count)
-		m[k] = stats
 	}
 
 	return m
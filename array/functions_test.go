@@ -58,6 +58,17 @@ func TestFind(t *testing.T) {
 	})
 }
 
+// test array.FindOk
+func TestFindOk(t *testing.T) {
+	a := []int{1, 3, 4, 5}
+	if b, ok := array.FindOk(a, func(x int) bool { return x%2 == 0 }); b != 4 || !ok {
+		t.Error("FindOk failed. Got", b, ok, "Expected", 4, true)
+	}
+	if b, ok := array.FindOk(a, func(x int) bool { return x > 5 }); b != 0 || ok {
+		t.Error("FindOk failed. Got", b, ok, "Expected", 0, false)
+	}
+}
+
 // test array.Map
 func TestMap(t *testing.T) {
 	a := []int{1, 2, 3, 4, 5}
@@ -84,6 +95,25 @@ func TestReduce(t *testing.T) {
 	}
 }
 
+// test array.Fold
+func TestFold(t *testing.T) {
+	a := []int{1, 2, 3}
+	b := array.Fold(a, "", func(acc string, x int) string { return acc + fmt.Sprint(x) })
+	if b != "123" {
+		t.Error("Fold failed. Got", b, "Expected", "123")
+	}
+}
+
+// test array.Zip
+func TestZip(t *testing.T) {
+	a := []string{"a", "b", "c"}
+	b := []int{1, 2}
+	c := array.Zip(a, b, func(s string, n int) string { return s + fmt.Sprint(n) })
+	if !reflect.DeepEqual(c, []string{"a1", "b2"}) {
+		t.Error("Zip failed. Got", c, "Expected", []string{"a1", "b2"})
+	}
+}
+
 // test array.Some
 func TestSome(t *testing.T) {
 	a := []int{1, 2, 3, 4, 5}
@@ -147,6 +177,25 @@ func TestSum(t *testing.T) {
 	}
 }
 
+// test array.Avg
+func TestAvg(t *testing.T) {
+	a := []int{1, 2, 3, 4}
+	b := array.Avg(a)
+	if b != 2.5 {
+		t.Error("Avg failed. Got", b, "Expected", 2.5)
+	}
+}
+
+// test array.Stats
+func TestStats(t *testing.T) {
+	a := []int{4, 1, 3, 2}
+	b := array.Stats(a)
+	expected := array.GroupStats[int]{Count: 4, Sum: 10, Min: 1, Max: 4, Avg: 2.5}
+	if b != expected {
+		t.Error("Stats failed. Got", b, "Expected", expected)
+	}
+}
+
 // test array.Max
 func TestMax(t *testing.T) {
 	a := []int{1, 2, 3, 4, 5}
@@ -211,6 +260,29 @@ func TestUnique(t *testing.T) {
 	}
 }
 
+// test array.Intersect and array.Difference
+func TestIntersectDifference(t *testing.T) {
+	a := []int{1, 2, 2, 3, 4}
+	b := []int{2, 4, 6}
+	if c := array.Intersect(a, b); !reflect.DeepEqual(c, []int{2, 4}) {
+		t.Error("Intersect failed. Got", c, "Expected", []int{2, 4})
+	}
+	if c := array.Difference(a, b); !reflect.DeepEqual(c, []int{1, 3}) {
+		t.Error("Difference failed. Got", c, "Expected", []int{1, 3})
+	}
+}
+
+// test array.Count and array.Frequencies
+func TestCountFrequencies(t *testing.T) {
+	a := []string{"a", "b", "a"}
+	if b := array.Count(a, "a"); b != 2 {
+		t.Error("Count failed. Got", b, "Expected", 2)
+	}
+	if b := array.Frequencies(a); !reflect.DeepEqual(b, map[string]int{"a": 2, "b": 1}) {
+		t.Error("Frequencies failed. Got", b, "Expected", map[string]int{"a": 2, "b": 1})
+	}
+}
+
 // test array.Union
 func TestUnion(t *testing.T) {
 	a := []int{1, 2, 3, 4, 5}
@@ -239,6 +311,94 @@ func TestFill(t *testing.T) {
 	}
 }
 
+func TestFillClamps(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5}
+	for _, c := range []struct {
+		start, end int
+		exp        []int
+	}{
+		{-2, 99, []int{1, 2, 3, 0, 0}},
+		{-99, 1, []int{0, 2, 3, 4, 5}},
+		{3, 1, []int{1, 2, 3, 4, 5}},
+		{7, 9, []int{1, 2, 3, 4, 5}},
+	} {
+		if got := array.Fill(a, c.start, c.end, 0); !reflect.DeepEqual(got, c.exp) {
+			t.Error("Fill failed for", c.start, c.end, "Got", got, "Expected", c.exp)
+		}
+	}
+}
+
+func TestSlice(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5}
+	for _, c := range []struct {
+		start, end int
+		exp        []int
+	}{
+		{1, 3, []int{2, 3}},
+		{-2, 99, []int{4, 5}},
+		{-99, 2, []int{1, 2}},
+		{0, -1, []int{1, 2, 3, 4}},
+		{3, 1, []int{}},
+		{9, 12, []int{}},
+	} {
+		if got := array.Slice(a, c.start, c.end); !reflect.DeepEqual(got, c.exp) {
+			t.Error("Slice failed for", c.start, c.end, "Got", got, "Expected", c.exp)
+		}
+	}
+
+	b := array.Slice(a, 0, 2)
+	b[0] = 9
+	if a[0] != 1 {
+		t.Error("Slice aliased its input. Got", a)
+	}
+}
+
+func TestSplice(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5}
+
+	t.Run("test replace", func(t *testing.T) {
+		b, removed := array.Splice(a, 1, 2, 8, 9, 10)
+		if !reflect.DeepEqual(b, []int{1, 8, 9, 10, 4, 5}) || !reflect.DeepEqual(removed, []int{2, 3}) {
+			t.Error("Splice failed. Got", b, removed)
+		}
+		if !reflect.DeepEqual(a, []int{1, 2, 3, 4, 5}) {
+			t.Error("Splice modified its input. Got", a)
+		}
+	})
+
+	t.Run("test bounds", func(t *testing.T) {
+		b, removed := array.Splice(a, -2, 99)
+		if !reflect.DeepEqual(b, []int{1, 2, 3}) || !reflect.DeepEqual(removed, []int{4, 5}) {
+			t.Error("Splice failed. Got", b, removed)
+		}
+		b, removed = array.Splice(a, 9, -1, 6)
+		if !reflect.DeepEqual(b, []int{1, 2, 3, 4, 5, 6}) || len(removed) != 0 {
+			t.Error("Splice failed. Got", b, removed)
+		}
+	})
+
+	t.Run("test insert and remove", func(t *testing.T) {
+		if got := array.InsertAt([]int{1, 2, 5}, 2, 3, 4); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+			t.Error("InsertAt failed. Got", got)
+		}
+		if got := array.InsertAt([]int{1, 2}, -1, 0); !reflect.DeepEqual(got, []int{1, 0, 2}) {
+			t.Error("InsertAt failed. Got", got)
+		}
+		if got := array.RemoveAt(a, -1); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+			t.Error("RemoveAt failed. Got", got)
+		}
+		if got := array.RemoveAt(a, 1); !reflect.DeepEqual(got, []int{1, 3, 4, 5}) {
+			t.Error("RemoveAt failed. Got", got)
+		}
+		if got := array.RemoveAt(a, 5); !reflect.DeepEqual(got, a) {
+			t.Error("RemoveAt failed. Got", got)
+		}
+		if got := array.RemoveWhere(a, func(x int) bool { return x > 2 }); !reflect.DeepEqual(got, []int{1, 2}) {
+			t.Error("RemoveWhere failed. Got", got)
+		}
+	})
+}
+
 // test array.Join
 func TestJoin(t *testing.T) {
 	a := []int{1, 2, 3, 4, 5}
@@ -380,6 +540,14 @@ func TestGroupBy(t *testing.T) {
 	}
 }
 
+func TestGroups(t *testing.T) {
+	groups := array.Groups([]int{1, 2, 3, 4, 5}, func(x int) bool { return x%2 == 0 })
+	expected := []array.Group[bool, int]{{false, []int{1, 3, 5}}, {true, []int{2, 4}}}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Error("Groups failed. Got", groups, "Expected", expected)
+	}
+}
+
 func TestGroupSumBy(t *testing.T) {
 	type Itens struct {
 		Name        string
@@ -483,6 +651,36 @@ func TestRowHelpers(t *testing.T) {
 		}
 	})
 
+	t.Run("GroupStats Add and Merge", func(t *testing.T) {
+		key := func(item Itens) string { return item.Name }
+		qty := func(item Itens) int { return item.Qty }
+		expected := array.GroupStatsBy(itens, key, qty)
+
+		merged := array.MergeGroupStats(
+			array.GroupStatsBy(itens[:2], key, qty),
+			array.GroupStatsBy(itens[2:4], key, qty),
+			array.GroupStatsBy(itens[4:], key, qty),
+		)
+		if !reflect.DeepEqual(merged, expected) {
+			t.Error("MergeGroupStats failed. Got", merged, "Expected", expected)
+		}
+
+		var stats array.GroupStats[int]
+		for _, item := range itens[3:] {
+			stats.Add(item.Qty)
+		}
+		if stats != expected["Item 4"] {
+			t.Error("GroupStats.Add failed. Got", stats, "Expected", expected["Item 4"])
+		}
+
+		var empty array.GroupStats[int]
+		empty.Merge(stats)
+		stats.Merge(array.GroupStats[int]{})
+		if empty != stats {
+			t.Error("GroupStats.Merge failed. Got", empty, "Expected", stats)
+		}
+	})
+
 	t.Run("DistinctBy", func(t *testing.T) {
 		distinct := array.DistinctBy(itens, func(item Itens) string { return item.Name })
 		if len(distinct) != 4 || distinct[3].Qty != 10 {
@@ -0,0 +1,275 @@
+package array
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+/* Heap is a priority queue ordered by a compare function: Pop and Peek return
+* the smallest element, the one that sorts first. Use Descending, or MaxHeap,
+* to pop the largest first. Push and Pop are O(log n); building a heap from n
+* elements and merging heaps is O(n).
+* Example:
+*   jobs := NewHeap(SortKey[Job, int](func(j Job) int { return j.Priority }).Compare, pending...)
+*   jobs.Push(Job{Name: "urgent", Priority: 0})
+*   next := jobs.Pop() // urgent
+ */
+type Heap[T any] struct {
+	items   []T
+	compare func(a, b T) int
+}
+
+// NewHeap builds a heap from a copy of items.
+func NewHeap[T any](compare func(a, b T) int, items ...T) *Heap[T] {
+	h := &Heap[T]{items: slices.Clone(items), compare: compare}
+	h.heapify()
+	return h
+}
+
+// NewHeapBy builds a heap that pops the element with the smallest key first.
+func NewHeapBy[T any, K cmp.Ordered](key func(T) K, items ...T) *Heap[T] {
+	return NewHeap(SortKey[T, K](key).Compare, items...)
+}
+
+// MinHeap builds a heap that pops the smallest value first.
+func MinHeap[T cmp.Ordered](items ...T) *Heap[T] {
+	return NewHeap(cmp.Compare[T], items...)
+}
+
+// MaxHeap builds a heap that pops the largest value first.
+func MaxHeap[T cmp.Ordered](items ...T) *Heap[T] {
+	return NewHeap(func(a, b T) int { return cmp.Compare(b, a) }, items...)
+}
+
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *Heap[T]) Push(x ...T) {
+	for _, e := range x {
+		h.items = append(h.items, e)
+		h.up(len(h.items) - 1)
+	}
+}
+
+// Pop removes and returns the first element. It panics if the heap is empty.
+func (h *Heap[T]) Pop() T {
+	if len(h.items) == 0 {
+		panic("cannot Pop an empty heap")
+	}
+	return h.Remove(0)
+}
+
+// Peek returns the first element without removing it; ok is false when the heap is empty.
+func (h *Heap[T]) Peek() (x T, ok bool) {
+	if len(h.items) == 0 {
+		return x, false
+	}
+	return h.items[0], true
+}
+
+// Update replaces the element at index i and restores the heap order. Indexes
+// come from All or IndexFunc. It panics if i is out of range.
+func (h *Heap[T]) Update(i int, x T) {
+	h.checkIndex(i)
+	h.items[i] = x
+	if !h.down(i) {
+		h.up(i)
+	}
+}
+
+// Remove removes and returns the element at index i. It panics if i is out of range.
+func (h *Heap[T]) Remove(i int) T {
+	h.checkIndex(i)
+	x := h.items[i]
+	last := len(h.items) - 1
+	h.items[i] = h.items[last]
+	var zero T
+	h.items[last] = zero
+	h.items = h.items[:last]
+	if i < last && !h.down(i) {
+		h.up(i)
+	}
+
+	return x
+}
+
+// IndexFunc returns the index of the first element, in heap order, satisfying f,
+// or -1 if none does.
+func (h *Heap[T]) IndexFunc(f func(T) bool) int {
+	return slices.IndexFunc(h.items, f)
+}
+
+// Merge adds the elements of other to h in O(n) and leaves other unchanged.
+// Both heaps are expected to share the same order; h keeps its own.
+func (h *Heap[T]) Merge(other *Heap[T]) {
+	h.items = append(h.items, other.items...)
+	h.heapify()
+}
+
+// All iterates over the indexes and elements in heap order, which is not sorted.
+func (h *Heap[T]) All() iter.Seq2[int, T] {
+	return slices.All(h.items)
+}
+
+// Sorted returns a copy of the elements sorted by the heap order, without
+// changing the heap.
+func (h *Heap[T]) Sorted() []T {
+	return slices.SortedStableFunc(slices.Values(h.items), h.compare)
+}
+
+func (h *Heap[T]) heapify() {
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+}
+
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if h.compare(h.items[i], h.items[parent]) >= 0 {
+			return
+		}
+		h.items[i], h.items[parent] = h.items[parent], h.items[i]
+		i = parent
+	}
+}
+
+// down moves the element at index i down and reports whether it moved.
+func (h *Heap[T]) down(i int) bool {
+	start := i
+	n := len(h.items)
+	for {
+		child := 2*i + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && h.compare(h.items[right], h.items[child]) < 0 {
+			child = right
+		}
+		if h.compare(h.items[child], h.items[i]) >= 0 {
+			break
+		}
+		h.items[i], h.items[child] = h.items[child], h.items[i]
+		i = child
+	}
+
+	return i > start
+}
+
+func (h *Heap[T]) checkIndex(i int) {
+	if i < 0 || i >= len(h.items) {
+		panic("heap index out of range")
+	}
+}
+
+/* TopK returns the k greatest elements by compare, greatest first, in
+* O(n log k). It returns all the elements, sorted, if k exceeds the length.
+* Example:
+*   a := []int{5, 1, 4, 2, 3}
+*   fmt.Println(TopK(a, 2, cmp.Compare[int])) // [5 4]
+ */
+func TopK[T any](a []T, k int, compare func(a, b T) int) []T {
+	if k < 0 {
+		panic("k must not be negative")
+	}
+	h := &Heap[T]{items: make([]T, 0, min(k, len(a))+1), compare: compare}
+	for _, x := range a {
+		if h.Len() < k {
+			h.Push(x)
+		} else if k > 0 && compare(x, h.items[0]) > 0 {
+			h.Update(0, x)
+		}
+	}
+
+	top := make([]T, h.Len())
+	for i := len(top) - 1; i >= 0; i-- {
+		top[i] = h.Pop()
+	}
+
+	return top
+}
+
+// TopKBy returns the k elements with the greatest keys, greatest first.
+func TopKBy[T any, K cmp.Ordered](a []T, k int, key func(T) K) []T {
+	return TopK(a, k, SortKey[T, K](key).Compare)
+}
+
+/* MergeSorted merges slices that are each sorted by compare into one sorted
+* slice, in O(n log k) for k slices. Equal elements keep the order of the
+* slices they come from.
+* Example:
+*   a := MergeSorted(cmp.Compare[int], []int{1, 4, 7}, []int{2, 5}, []int{3, 6})
+*   fmt.Println(a) // [1 2 3 4 5 6 7]
+ */
+func MergeSorted[T any](compare func(a, b T) int, lists ...[]T) []T {
+	type cursor struct {
+		list, pos int
+	}
+	total := 0
+	for _, l := range lists {
+		total += len(l)
+	}
+	h := &Heap[cursor]{compare: func(a, b cursor) int {
+		if c := compare(lists[a.list][a.pos], lists[b.list][b.pos]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.list, b.list)
+	}}
+	for i, l := range lists {
+		if len(l) > 0 {
+			h.items = append(h.items, cursor{list: i})
+		}
+	}
+	h.heapify()
+
+	merged := make([]T, 0, total)
+	for h.Len() > 0 {
+		c := h.items[0]
+		merged = append(merged, lists[c.list][c.pos])
+		if c.pos+1 < len(lists[c.list]) {
+			h.Update(0, cursor{list: c.list, pos: c.pos + 1})
+		} else {
+			h.Pop()
+		}
+	}
+
+	return merged
+}
+
+/* Schedule assigns each element, in order, to the worker with the least total
+* cost so far, and returns the elements of each worker. Ties go to the lowest
+* worker. Sorting the input by decreasing cost first gives a better balance.
+* It panics if workers is not positive.
+* Example:
+*   jobs := []int{5, 3, 3, 2}
+*   fmt.Println(Schedule(jobs, 2, func(n int) int { return n })) // [[5 2] [3 3]]
+ */
+func Schedule[T any, V Number](a []T, workers int, cost func(T) V) [][]T {
+	if workers <= 0 {
+		panic("workers must be positive")
+	}
+	type load struct {
+		worker int
+		total  V
+	}
+	h := &Heap[load]{items: make([]load, workers), compare: func(a, b load) int {
+		if c := cmp.Compare(a.total, b.total); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.worker, b.worker)
+	}}
+	for i := range h.items {
+		h.items[i].worker = i
+	}
+
+	assigned := make([][]T, workers)
+	for _, x := range a {
+		l := h.items[0]
+		assigned[l.worker] = append(assigned[l.worker], x)
+		h.Update(0, load{worker: l.worker, total: l.total + cost(x)})
+	}
+
+	return assigned
+}
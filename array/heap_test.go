@@ -0,0 +1,173 @@
+package array_test
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestHeap(t *testing.T) {
+	t.Run("test push and pop", func(t *testing.T) {
+		h := array.MinHeap(5, 1, 4)
+		h.Push(3, 2)
+		var got []int
+		for h.Len() > 0 {
+			got = append(got, h.Pop())
+		}
+		if !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+			t.Error("MinHeap failed. Got", got)
+		}
+		if _, ok := h.Peek(); ok {
+			t.Error("Peek failed on an empty heap")
+		}
+
+		max := array.MaxHeap(2, 9, 4)
+		if x, ok := max.Peek(); !ok || x != 9 || max.Len() != 3 {
+			t.Error("MaxHeap failed. Got", x, ok)
+		}
+	})
+
+	t.Run("test does not change the input", func(t *testing.T) {
+		items := []int{3, 1, 2}
+		array.MinHeap(items...).Pop()
+		if !reflect.DeepEqual(items, []int{3, 1, 2}) {
+			t.Error("MinHeap changed its input. Got", items)
+		}
+	})
+
+	t.Run("test update and remove", func(t *testing.T) {
+		h := array.NewHeapBy(func(m member) int { return m.Age }, member{"Ann", 30}, member{"Bob", 20}, member{"Cid", 40})
+		i := h.IndexFunc(func(m member) bool { return m.Name == "Cid" })
+		h.Update(i, member{"Cid", 10})
+		if x := h.Pop(); x.Name != "Cid" {
+			t.Error("Update failed. Got", x)
+		}
+		if x := h.Remove(h.IndexFunc(func(m member) bool { return m.Name == "Bob" })); x.Name != "Bob" {
+			t.Error("Remove failed. Got", x)
+		}
+		if x := h.Pop(); x.Name != "Ann" || h.Len() != 0 {
+			t.Error("Remove broke the heap. Got", x)
+		}
+		if h.IndexFunc(func(m member) bool { return true }) != -1 {
+			t.Error("IndexFunc failed on an empty heap")
+		}
+	})
+
+	t.Run("test merge and sorted", func(t *testing.T) {
+		a := array.MinHeap(5, 1)
+		b := array.MinHeap(4, 2, 3)
+		a.Merge(b)
+		if got := a.Sorted(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+			t.Error("Merge failed. Got", got)
+		}
+		if b.Len() != 3 || a.Len() != 5 {
+			t.Error("Merge changed the other heap. Got", b.Len())
+		}
+	})
+
+	t.Run("test random operations against a sorted slice", func(t *testing.T) {
+		r := rand.New(rand.NewPCG(1, 2))
+		h := array.MinHeap[int]()
+		var model []int
+		for step := 0; step < 2000; step++ {
+			switch op := r.IntN(4); {
+			case op < 2:
+				x := r.IntN(100)
+				h.Push(x)
+				model = append(model, x)
+			case op == 2 && h.Len() > 0:
+				slices.Sort(model)
+				if x := h.Pop(); x != model[0] {
+					t.Fatal("Pop failed at step", step, "Got", x, "Expected", model[0])
+				}
+				model = model[1:]
+			case op == 3 && h.Len() > 0:
+				i := r.IntN(h.Len())
+				var old int
+				for j, x := range h.All() {
+					if j == i {
+						old = x
+					}
+				}
+				x := r.IntN(100)
+				h.Update(i, x)
+				model[slices.Index(model, old)] = x
+			}
+		}
+		slices.Sort(model)
+		if got := h.Sorted(); !slices.Equal(got, model) {
+			t.Error("heap diverged. Got", got, "Expected", model)
+		}
+	})
+
+	t.Run("test panics", func(t *testing.T) {
+		for name, f := range map[string]func(){
+			"Pop":      func() { array.MinHeap[int]().Pop() },
+			"Update":   func() { array.MinHeap(1).Update(1, 0) },
+			"Remove":   func() { array.MinHeap(1).Remove(-1) },
+			"TopK":     func() { array.TopK([]int{1}, -1, cmp.Compare[int]) },
+			"Schedule": func() { array.Schedule([]int{1}, 0, func(n int) int { return n }) },
+		} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Error(name, "should panic")
+					}
+				}()
+				f()
+			}()
+		}
+	})
+}
+
+func TestTopK(t *testing.T) {
+	a := []int{5, 1, 4, 2, 3}
+	if got := array.TopK(a, 2, cmp.Compare[int]); !reflect.DeepEqual(got, []int{5, 4}) {
+		t.Error("TopK failed. Got", got, "Expected", []int{5, 4})
+	}
+	if got := array.TopK(a, 10, cmp.Compare[int]); !reflect.DeepEqual(got, []int{5, 4, 3, 2, 1}) {
+		t.Error("TopK failed. Got", got)
+	}
+	if got := array.TopK(a, 0, cmp.Compare[int]); len(got) != 0 {
+		t.Error("TopK failed. Got", got)
+	}
+
+	people := array.Array[member]{{"Ann", 30}, {"Bob", 25}, {"Cid", 40}}
+	got := people.TopKBy(1, func(m member) float64 { return float64(m.Age) })
+	if !reflect.DeepEqual(got, array.Array[member]{{"Cid", 40}}) {
+		t.Error("TopKBy failed. Got", got)
+	}
+	youngest := array.TopKBy(people, 1, func(m member) int { return -m.Age })
+	if youngest[0].Name != "Bob" {
+		t.Error("TopKBy failed. Got", youngest)
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	got := array.MergeSorted(cmp.Compare[int], []int{1, 4, 7}, nil, []int{2, 5}, []int{3, 6})
+	if !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5, 6, 7}) {
+		t.Error("MergeSorted failed. Got", got)
+	}
+
+	byAge := func(a, b member) int { return cmp.Compare(a.Age, b.Age) }
+	merged := array.Array[member]{{"Ann", 20}, {"Bob", 30}}.MergeSorted(byAge, []member{{"Cid", 20}})
+	if !reflect.DeepEqual(merged, array.Array[member]{{"Ann", 20}, {"Cid", 20}, {"Bob", 30}}) {
+		t.Error("MergeSorted is not stable. Got", merged)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	got := array.Schedule([]int{5, 3, 3, 2}, 2, func(n int) int { return n })
+	if !reflect.DeepEqual(got, [][]int{{5, 2}, {3, 3}}) {
+		t.Error("Schedule failed. Got", got)
+	}
+
+	chained := array.Numbers[float64]{1, 1, 1}.Schedule(4, func(f float64) float64 { return f })
+	if len(chained) != 4 || len(chained[3]) != 0 {
+		t.Error("Schedule failed. Got", chained)
+	}
+}
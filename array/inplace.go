@@ -0,0 +1,142 @@
+package array
+
+import (
+	"cmp"
+	"slices"
+)
+
+/* The 0Loc functions work in place: they reorder or overwrite the elements of
+* their input instead of allocating a new slice, and the functions that drop
+* elements return the shortened input. Reverse0Loc, Sort0Loc, SortBy0Loc,
+* Compact0Loc, Partition0Loc and Rotate0Loc never allocate; Unique0Loc and
+* DistinctBy0Loc only allocate the set of keys they have seen.
+* Use them on hot paths where the input is not needed anymore.
+* Example:
+*   a := []int{3, 1, 3, 2, 1}
+*   a = Unique0Loc(a)
+*   Sort0Loc(a, cmp.Compare[int])
+*   fmt.Println(a) // [1 2 3]
+ */
+
+func Reverse0Loc[T any](a []T) {
+	slices.Reverse(a)
+}
+
+// Sort0Loc sorts the slice with compare, keeping the order of equal elements.
+func Sort0Loc[T any](a []T, compare func(a, b T) int) {
+	slices.SortStableFunc(a, compare)
+}
+
+// SortBy0Loc sorts the slice by key, keeping the order of equal keys.
+func SortBy0Loc[T any, K cmp.Ordered](a []T, key func(T) K) {
+	slices.SortStableFunc(a, func(x, y T) int {
+		return cmp.Compare(key(x), key(y))
+	})
+}
+
+// Unique0Loc keeps the first occurrence of each element and returns the shortened slice.
+func Unique0Loc[T comparable](a []T) []T {
+	seen := make(map[T]struct{})
+	i := 0
+	for _, x := range a {
+		if _, ok := seen[x]; !ok {
+			seen[x] = struct{}{}
+			a[i] = x
+			i++
+		}
+	}
+	clear(a[i:])
+	return a[:i]
+}
+
+// DistinctBy0Loc keeps the first element of each key and returns the shortened slice.
+func DistinctBy0Loc[T any, K comparable](a []T, key func(T) K) []T {
+	seen := make(map[K]struct{})
+	i := 0
+	for _, x := range a {
+		k := key(x)
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			a[i] = x
+			i++
+		}
+	}
+	clear(a[i:])
+	return a[:i]
+}
+
+/* Compact0Loc removes the zero values and returns the shortened slice.
+* Example:
+*   a := []string{"a", "", "b", ""}
+*   fmt.Println(Compact0Loc(a)) // [a b]
+ */
+func Compact0Loc[T comparable](a []T) []T {
+	var zero T
+	i := 0
+	for _, x := range a {
+		if x != zero {
+			a[i] = x
+			i++
+		}
+	}
+	clear(a[i:])
+	return a[:i]
+}
+
+/* Partition0Loc moves the elements that satisfy f to the front, keeping the
+* order within both groups, and returns the two groups as subslices of a.
+* It calls f once per element and runs in O(n log n) without allocating.
+* Example:
+*   a := []int{1, 2, 3, 4, 5}
+*   even, odd := Partition0Loc(a, func(x int) bool { return x%2 == 0 })
+*   fmt.Println(even, odd, a) // [2 4] [1 3 5] [2 4 1 3 5]
+ */
+func Partition0Loc[T any](a []T, f func(T) bool) ([]T, []T) {
+	n := stablePartition(a, f)
+	return a[:n], a[n:]
+}
+
+// stablePartition partitions both halves, then rotates the unmatched elements
+// of the left half past the matched elements of the right half.
+func stablePartition[T any](a []T, f func(T) bool) int {
+	switch len(a) {
+	case 0:
+		return 0
+	case 1:
+		if f(a[0]) {
+			return 1
+		}
+		return 0
+	}
+
+	mid := len(a) / 2
+	left := stablePartition(a[:mid], f)
+	right := stablePartition(a[mid:], f)
+	rotateLeft(a[left:mid+right], mid-left)
+
+	return left + right
+}
+
+/* Rotate0Loc moves every element k positions to the left, wrapping around;
+* a negative k rotates to the right.
+* Example:
+*   a := []int{1, 2, 3, 4, 5}
+*   Rotate0Loc(a, 2)
+*   fmt.Println(a) // [3 4 5 1 2]
+ */
+func Rotate0Loc[T any](a []T, k int) {
+	if len(a) == 0 {
+		return
+	}
+	k %= len(a)
+	if k < 0 {
+		k += len(a)
+	}
+	rotateLeft(a, k)
+}
+
+func rotateLeft[T any](a []T, k int) {
+	slices.Reverse(a[:k])
+	slices.Reverse(a[k:])
+	slices.Reverse(a)
+}
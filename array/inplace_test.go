@@ -0,0 +1,185 @@
+package array_test
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+type member struct {
+	Name string
+	Age  int
+}
+
+func TestReverse0Loc(t *testing.T) {
+	a := []int{1, 2, 3, 4}
+	array.Reverse0Loc(a)
+	if !reflect.DeepEqual(a, []int{4, 3, 2, 1}) {
+		t.Error("Reverse0Loc failed. Got", a, "Expected", []int{4, 3, 2, 1})
+	}
+}
+
+func TestSort0Loc(t *testing.T) {
+	a := []member{{"John", 30}, {"Doe", 25}, {"Jane", 30}, {"Ann", 25}}
+	array.Sort0Loc(a, func(x, y member) int { return cmp.Compare(x.Age, y.Age) })
+	exp := []member{{"Doe", 25}, {"Ann", 25}, {"John", 30}, {"Jane", 30}}
+	if !reflect.DeepEqual(a, exp) {
+		t.Error("Sort0Loc failed. Got", a, "Expected", exp)
+	}
+
+	array.SortBy0Loc(a, func(p member) string { return p.Name })
+	exp = []member{{"Ann", 25}, {"Doe", 25}, {"Jane", 30}, {"John", 30}}
+	if !reflect.DeepEqual(a, exp) {
+		t.Error("SortBy0Loc failed. Got", a, "Expected", exp)
+	}
+}
+
+func TestUnique0Loc(t *testing.T) {
+	a := []int{3, 1, 3, 2, 1}
+	b := array.Unique0Loc(a)
+	if !reflect.DeepEqual(b, []int{3, 1, 2}) || &a[0] != &b[0] {
+		t.Error("Unique0Loc failed. Got", b, "Expected", []int{3, 1, 2})
+	}
+
+	people := []member{{"John", 30}, {"Doe", 25}, {"Jane", 30}}
+	distinct := array.DistinctBy0Loc(people, func(p member) int { return p.Age })
+	if !reflect.DeepEqual(distinct, []member{{"John", 30}, {"Doe", 25}}) {
+		t.Error("DistinctBy0Loc failed. Got", distinct)
+	}
+}
+
+func TestCompact0Loc(t *testing.T) {
+	a := []string{"a", "", "b", "", "c"}
+	b := array.Compact0Loc(a)
+	if !reflect.DeepEqual(b, []string{"a", "b", "c"}) {
+		t.Error("Compact0Loc failed. Got", b, "Expected", []string{"a", "b", "c"})
+	}
+	if a[3] != "" || a[4] != "" {
+		t.Error("Compact0Loc should clear the tail. Got", a)
+	}
+}
+
+func TestPartition0Loc(t *testing.T) {
+	t.Run("test stable", func(t *testing.T) {
+		a := []int{1, 2, 3, 4, 5, 6, 7}
+		even, odd := array.Partition0Loc(a, func(x int) bool { return x%2 == 0 })
+		if !reflect.DeepEqual(even, []int{2, 4, 6}) || !reflect.DeepEqual(odd, []int{1, 3, 5, 7}) {
+			t.Error("Partition0Loc failed. Got", even, odd)
+		}
+		if !reflect.DeepEqual(a, []int{2, 4, 6, 1, 3, 5, 7}) {
+			t.Error("Partition0Loc failed. Got", a)
+		}
+	})
+
+	t.Run("test calls f once per element", func(t *testing.T) {
+		a := make([]int, 100)
+		for i := range a {
+			a[i] = (i * 37) % 100
+		}
+		calls := 0
+		small, large := array.Partition0Loc(a, func(x int) bool { calls++; return x < 30 })
+		if calls != 100 || len(small) != 30 || len(large) != 70 {
+			t.Error("Partition0Loc failed. Got", calls, len(small), len(large))
+		}
+		for i := 1; i < len(small); i++ {
+			if (small[i]*73)%100 < (small[i-1]*73)%100 {
+				t.Error("Partition0Loc is not stable. Got", small)
+				break
+			}
+		}
+	})
+
+	t.Run("test empty", func(t *testing.T) {
+		matched, unmatched := array.Partition0Loc([]int{}, func(x int) bool { return true })
+		if len(matched) != 0 || len(unmatched) != 0 {
+			t.Error("Partition0Loc failed. Got", matched, unmatched)
+		}
+	})
+}
+
+func TestRotate0Loc(t *testing.T) {
+	for _, c := range []struct {
+		k   int
+		exp []int
+	}{
+		{2, []int{3, 4, 5, 1, 2}},
+		{-1, []int{5, 1, 2, 3, 4}},
+		{7, []int{3, 4, 5, 1, 2}},
+		{0, []int{1, 2, 3, 4, 5}},
+	} {
+		a := []int{1, 2, 3, 4, 5}
+		array.Rotate0Loc(a, c.k)
+		if !reflect.DeepEqual(a, c.exp) {
+			t.Error("Rotate0Loc failed for", c.k, "Got", a, "Expected", c.exp)
+		}
+	}
+	array.Rotate0Loc([]int{}, 3)
+}
+
+func TestInPlaceAllocations(t *testing.T) {
+	a := make([]int, 1000)
+	isEven := func(x int) bool { return x%2 == 0 }
+	byValue := func(x, y int) int { return cmp.Compare(x, y) }
+	key := func(x int) int { return -x }
+	reset := func() {
+		for i := range a {
+			a[i] = (i * 7919) % 1000
+		}
+	}
+
+	for name, f := range map[string]func(){
+		"Reverse0Loc":   func() { array.Reverse0Loc(a) },
+		"Sort0Loc":      func() { reset(); array.Sort0Loc(a, byValue) },
+		"SortBy0Loc":    func() { reset(); array.SortBy0Loc(a, key) },
+		"Compact0Loc":   func() { reset(); array.Compact0Loc(a) },
+		"Partition0Loc": func() { reset(); array.Partition0Loc(a, isEven) },
+		"Rotate0Loc":    func() { array.Rotate0Loc(a, 333) },
+		"Filter0Loc":    func() { reset(); array.Filter0Loc(a, isEven) },
+		"Map0Loc":       func() { array.Map0Loc(a, key) },
+		"Mutable":       func() { reset(); array.Array[int](a).Mutable().Filter(isEven).Sort(byValue).Rotate(1) },
+	} {
+		if allocs := testing.AllocsPerRun(10, f); allocs != 0 {
+			t.Error(name, "allocated. Got", allocs, "Expected", 0)
+		}
+	}
+}
+
+func TestMutable(t *testing.T) {
+	t.Run("test chain works in place", func(t *testing.T) {
+		a := array.Array[int]{5, 2, 8, 1, 9, 4}
+		got := a.Mutable().
+			Filter(func(x int) bool { return x > 1 }).
+			Map(func(x int) int { return x * 10 }).
+			SortByKey(array.Descending[int](array.CompareFunc[int](cmp.Compare[int]))).
+			Array().
+			Take(3)
+		if !reflect.DeepEqual(got, array.Array[int]{90, 80, 50}) || a[0] != 90 {
+			t.Error("Mutable failed. Got", got)
+		}
+	})
+
+	t.Run("test comparable chain", func(t *testing.T) {
+		ids := array.ComparableArray[string]{"b", "", "a", "b", "c", ""}
+		got := ids.Mutable().Compact().Unique().Reverse().Rotate(1).Comparable()
+		if !reflect.DeepEqual(got, array.ComparableArray[string]{"a", "b", "c"}) {
+			t.Error("MutableComparable failed. Got", got)
+		}
+
+		matched, rest := array.ComparableArray[int]{1, 2, 3, 4}.Mutable().
+			Partition(func(x int) bool { return x > 2 })
+		if !reflect.DeepEqual(matched, array.MutableComparable[int]{3, 4}) || !reflect.DeepEqual(rest, array.MutableComparable[int]{1, 2}) {
+			t.Error("Partition failed. Got", matched, rest)
+		}
+	})
+
+	t.Run("test numbers and distinct", func(t *testing.T) {
+		got := array.Numbers[int]{3, 13, 4, 23}.Mutable().
+			DistinctBy(func(x int) string { return string(rune('0' + x%10)) }).
+			Array()
+		if !reflect.DeepEqual(got, array.Array[int]{3, 4}) {
+			t.Error("DistinctBy failed. Got", got)
+		}
+	})
+}
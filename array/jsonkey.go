@@ -0,0 +1,31 @@
+package array
+
+import "encoding/json"
+
+// marshalJSONKey encodes k as a JSON object key. Keys that do not encode as
+// JSON strings, such as numbers, are quoted.
+func marshalJSONKey[K any](k K) ([]byte, error) {
+	key, err := json.Marshal(k)
+	if err != nil {
+		return nil, err
+	}
+	if key[0] != '"' {
+		return json.Marshal(string(key))
+	}
+	return key, nil
+}
+
+// unmarshalJSONKey decodes a JSON object key written by marshalJSONKey.
+func unmarshalJSONKey[K any](s string) (K, error) {
+	var k K
+	quoted, err := json.Marshal(s)
+	if err != nil {
+		return k, err
+	}
+	if err := json.Unmarshal(quoted, &k); err != nil {
+		if err := json.Unmarshal([]byte(s), &k); err != nil {
+			return k, err
+		}
+	}
+	return k, nil
+}
@@ -0,0 +1,156 @@
+package array
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+/* Key is a typed key extractor. Chain methods cannot be generic, so the chain
+* takes keys through the Keyer and Comparer interfaces and returns them as any:
+* GroupByKey, IndexByKey and GroupCountByKey. The methods of Key itself, such as
+* GroupBy, Groups, IndexBy and GroupCountBy, return the keys with their type K.
+* Integer, time and struct keys work without formatting them as strings.
+* Example:
+*   byCustomer := Key[Order, int](func(o Order) int { return o.CustomerID })
+*   firstOrders := Array[Order](orders).DistinctByKey(byCustomer)
+*   counts := Array[Order](orders).GroupCountByKey(byCustomer) // map[any]int
+*   grouped := byCustomer.GroupBy(orders)                       // map[int][]Order
+ */
+type Key[T any, K comparable] func(T) K
+
+// Keyer is implemented by Key, whatever its key type. Its methods return the
+// keys as any.
+type Keyer[T any] interface {
+	DistinctBy(w []T) []T
+	GroupsAny(w []T) []Group[any, T]
+	IndexByAny(w []T) map[any]T
+	IndexByAnyOrdered(w []T) *OrderedMap[any, T]
+	GroupCountByAny(w []T) map[any]int
+	GroupCountByAnyOrdered(w []T) *OrderedMap[any, int]
+}
+
+// Comparer is implemented by SortKey, TimeKey and CompareFunc.
+type Comparer[T any] interface {
+	Compare(a, b T) int
+}
+
+func (k Key[T, K]) GroupBy(w []T) map[K][]T {
+	return GroupBy(w, k)
+}
+
+func (k Key[T, K]) GroupByOrdered(w []T) *OrderedMap[K, []T] {
+	return GroupByOrdered(w, k)
+}
+
+func (k Key[T, K]) Groups(w []T) []Group[K, T] {
+	return Groups(w, k)
+}
+
+func (k Key[T, K]) IndexBy(w []T) map[K]T {
+	return IndexBy(w, k)
+}
+
+func (k Key[T, K]) IndexByOrdered(w []T) *OrderedMap[K, T] {
+	return IndexByOrdered(w, k)
+}
+
+func (k Key[T, K]) GroupCountBy(w []T) map[K]int {
+	return GroupCountBy(w, k)
+}
+
+func (k Key[T, K]) GroupCountByOrdered(w []T) *OrderedMap[K, int] {
+	return GroupCountByOrdered(w, k)
+}
+
+func (k Key[T, K]) DistinctBy(w []T) []T {
+	return DistinctBy(w, k)
+}
+
+// Split returns the items of each group, in the order their key first appears.
+func (k Key[T, K]) Split(w []T) [][]T {
+	return Map(Groups(w, k), func(g Group[K, T]) []T { return g.Items })
+}
+
+// GroupsAny is Groups with the keys as any.
+func (k Key[T, K]) GroupsAny(w []T) []Group[any, T] {
+	return Map(Groups(w, k), func(g Group[K, T]) Group[any, T] {
+		return Group[any, T]{Key: g.Key, Items: g.Items}
+	})
+}
+
+// IndexByAny is IndexBy with the keys as any.
+func (k Key[T, K]) IndexByAny(w []T) map[any]T {
+	return IndexBy(w, k.any)
+}
+
+// IndexByAnyOrdered is IndexByOrdered with the keys as any.
+func (k Key[T, K]) IndexByAnyOrdered(w []T) *OrderedMap[any, T] {
+	return IndexByOrdered(w, k.any)
+}
+
+// GroupCountByAny is GroupCountBy with the keys as any.
+func (k Key[T, K]) GroupCountByAny(w []T) map[any]int {
+	return GroupCountBy(w, k.any)
+}
+
+// GroupCountByAnyOrdered is GroupCountByOrdered with the keys as any.
+func (k Key[T, K]) GroupCountByAnyOrdered(w []T) *OrderedMap[any, int] {
+	return GroupCountByOrdered(w, k.any)
+}
+
+func (k Key[T, K]) any(x T) any {
+	return k(x)
+}
+
+// SortKey is a key extractor for ordered keys.
+type SortKey[T any, K cmp.Ordered] func(T) K
+
+func (k SortKey[T, K]) Compare(a, b T) int {
+	return cmp.Compare(k(a), k(b))
+}
+
+// TimeKey is a key extractor for time keys.
+type TimeKey[T any] func(T) time.Time
+
+func (k TimeKey[T]) Compare(a, b T) int {
+	return k(a).Compare(k(b))
+}
+
+// CompareFunc adapts a comparison function, like the ones slices.SortFunc takes, to Comparer.
+type CompareFunc[T any] func(a, b T) int
+
+func (f CompareFunc[T]) Compare(a, b T) int {
+	return f(a, b)
+}
+
+// Descending reverses the order of c.
+func Descending[T any](c Comparer[T]) CompareFunc[T] {
+	return func(a, b T) int {
+		return c.Compare(b, a)
+	}
+}
+
+/* ThenBy compares by each comparer in turn, so rows can be sorted by composite keys.
+* Example:
+*   byCityThenAge := ThenBy[Person](
+*       SortKey[Person, string](func(p Person) string { return p.City }),
+*       Descending[Person](SortKey[Person, int](func(p Person) int { return p.Age })),
+*   )
+ */
+func ThenBy[T any](comparers ...Comparer[T]) CompareFunc[T] {
+	return func(a, b T) int {
+		for _, c := range comparers {
+			if n := c.Compare(a, b); n != 0 {
+				return n
+			}
+		}
+		return 0
+	}
+}
+
+func sortByComparer[T any](w []T, c Comparer[T]) []T {
+	result := slices.Clone(w)
+	slices.SortStableFunc(result, c.Compare)
+	return result
+}
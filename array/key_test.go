@@ -0,0 +1,150 @@
+package array_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+type keyOrder struct {
+	ID       int
+	Customer int
+	Region   string
+	Placed   time.Time
+}
+
+type regionCustomer struct {
+	Region   string
+	Customer int
+}
+
+var keyOrders = array.Array[keyOrder]{
+	{1, 20, "north", time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)},
+	{2, 10, "south", time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+	{3, 20, "north", time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC)},
+	{4, 10, "north", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+}
+
+func orderIDs(orders []keyOrder) []int {
+	return array.Map(orders, func(o keyOrder) int { return o.ID })
+}
+
+func TestKey(t *testing.T) {
+	byCustomer := array.Key[keyOrder, int](func(o keyOrder) int { return o.Customer })
+
+	t.Run("test typed results", func(t *testing.T) {
+		grouped := byCustomer.GroupBy(keyOrders)
+		if got := orderIDs(grouped[20]); !reflect.DeepEqual(got, []int{1, 3}) {
+			t.Error("GroupBy failed. Got", got, "Expected", []int{1, 3})
+		}
+		if got := byCustomer.GroupCountBy(keyOrders); !reflect.DeepEqual(got, map[int]int{10: 2, 20: 2}) {
+			t.Error("GroupCountBy failed. Got", got)
+		}
+		if got := byCustomer.IndexBy(keyOrders)[10].ID; got != 4 {
+			t.Error("IndexBy failed. Got", got, "Expected", 4)
+		}
+		if got := slices.Collect(byCustomer.GroupByOrdered(keyOrders).Keys()); !reflect.DeepEqual(got, []int{20, 10}) {
+			t.Error("GroupByOrdered failed. Got", got)
+		}
+		if got := slices.Collect(byCustomer.GroupCountByOrdered(keyOrders).Values()); !reflect.DeepEqual(got, []int{2, 2}) {
+			t.Error("GroupCountByOrdered failed. Got", got)
+		}
+		if last, _ := byCustomer.IndexByOrdered(keyOrders).Get(20); last.ID != 3 {
+			t.Error("IndexByOrdered failed. Got", last)
+		}
+		groups := byCustomer.Groups(keyOrders)
+		if len(groups) != 2 || groups[0].Key != 20 || groups[1].Key != 10 {
+			t.Error("Groups failed. Got", groups)
+		}
+	})
+
+	t.Run("test struct keys on the chain", func(t *testing.T) {
+		key := array.Key[keyOrder, regionCustomer](func(o keyOrder) regionCustomer {
+			return regionCustomer{o.Region, o.Customer}
+		})
+
+		if got := orderIDs(keyOrders.DistinctByKey(key)); !reflect.DeepEqual(got, []int{1, 2, 4}) {
+			t.Error("DistinctByKey failed. Got", got, "Expected", []int{1, 2, 4})
+		}
+
+		groups := keyOrders.GroupByKey(key)
+		got := array.Map(groups, func(g array.Group[any, keyOrder]) []int { return orderIDs(g.Items) })
+		if !reflect.DeepEqual(got, [][]int{{1, 3}, {2}, {4}}) {
+			t.Error("GroupByKey failed. Got", got)
+		}
+		if groups[1].Key != (regionCustomer{"south", 10}) {
+			t.Error("GroupByKey lost the key. Got", groups[1].Key)
+		}
+
+		if got := keyOrders.IndexByKey(key)[regionCustomer{"north", 20}].ID; got != 3 {
+			t.Error("IndexByKey failed. Got", got, "Expected", 3)
+		}
+		ordered := keyOrders.GroupCountByKeyOrdered(key)
+		if k, v, _ := ordered.Back(); k != (regionCustomer{"north", 10}) || v != 1 {
+			t.Error("GroupCountByKeyOrdered failed. Got", k, v)
+		}
+		if k, v, _ := keyOrders.IndexByKeyOrdered(key).Front(); k != (regionCustomer{"north", 20}) || v.ID != 3 {
+			t.Error("IndexByKeyOrdered failed. Got", k, v)
+		}
+		counts := keyOrders.GroupCountByKey(key)
+		if len(counts) != 3 || counts[regionCustomer{"north", 20}] != 2 {
+			t.Error("GroupCountByKey failed. Got", counts)
+		}
+	})
+
+	t.Run("test empty array", func(t *testing.T) {
+		var empty array.Array[keyOrder]
+		if got := empty.GroupByKey(byCustomer); len(got) != 0 {
+			t.Error("GroupByKey failed. Got", got)
+		}
+		if got := empty.DistinctByKey(byCustomer); len(got) != 0 {
+			t.Error("DistinctByKey failed. Got", got)
+		}
+	})
+}
+
+func TestSortByKey(t *testing.T) {
+	placed := array.TimeKey[keyOrder](func(o keyOrder) time.Time { return o.Placed })
+	customer := array.SortKey[keyOrder, int](func(o keyOrder) int { return o.Customer })
+	id := array.SortKey[keyOrder, int](func(o keyOrder) int { return o.ID })
+
+	t.Run("test time key", func(t *testing.T) {
+		if got := orderIDs(keyOrders.SortByKey(placed)); !reflect.DeepEqual(got, []int{4, 2, 3, 1}) {
+			t.Error("SortByKey failed. Got", got, "Expected", []int{4, 2, 3, 1})
+		}
+	})
+
+	t.Run("test stable and descending", func(t *testing.T) {
+		if got := orderIDs(keyOrders.SortByKey(customer)); !reflect.DeepEqual(got, []int{2, 4, 1, 3}) {
+			t.Error("SortByKey failed. Got", got, "Expected", []int{2, 4, 1, 3})
+		}
+		if got := orderIDs(keyOrders.SortByKey(array.Descending[keyOrder](placed))); !reflect.DeepEqual(got, []int{1, 3, 2, 4}) {
+			t.Error("Descending failed. Got", got, "Expected", []int{1, 3, 2, 4})
+		}
+	})
+
+	t.Run("test composite key", func(t *testing.T) {
+		byCustomerThenID := array.ThenBy[keyOrder](customer, array.Descending[keyOrder](id))
+		if got := orderIDs(keyOrders.SortByKey(byCustomerThenID)); !reflect.DeepEqual(got, []int{4, 2, 3, 1}) {
+			t.Error("ThenBy failed. Got", got, "Expected", []int{4, 2, 3, 1})
+		}
+	})
+
+	t.Run("test input is not modified", func(t *testing.T) {
+		keyOrders.SortByKey(placed)
+		if got := orderIDs(keyOrders); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+			t.Error("SortByKey modified its input. Got", got)
+		}
+	})
+
+	t.Run("test compare func", func(t *testing.T) {
+		numbers := array.Numbers[int]{3, 1, 2}
+		desc := array.CompareFunc[int](func(a, b int) int { return b - a })
+		if got := numbers.SortByKey(desc); !reflect.DeepEqual(got, array.Numbers[int]{3, 2, 1}) {
+			t.Error("SortByKey failed. Got", got)
+		}
+	})
+}
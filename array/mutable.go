@@ -0,0 +1,115 @@
+package array
+
+/* Mutable is the opt-in mutable chain. Its methods are the 0Loc functions: they
+* work on the memory of the array they start from, so nothing is copied along
+* the chain, and the original array must not be used afterwards. Leave the
+* mode with Array. ComparableArray.Mutable returns a MutableComparable, which
+* adds Unique and Compact.
+* Example:
+*   top := Array[Order](orders).Mutable().
+*       Filter(isPaid).
+*       SortByKey(Descending[Order](byTotal)).
+*       Array().
+*       Take(10)
+ */
+type Mutable[T any] []T
+
+// MutableComparable is the mutable chain for comparable values. It has every
+// Mutable method, returning MutableComparable instead of Mutable.
+type MutableComparable[T comparable] []T
+
+func (a Mutable[T]) Array() Array[T] {
+	return Array[T](a)
+}
+
+func (a Mutable[T]) Filter(f func(T) bool) Mutable[T] {
+	return Filter0Loc(a, f)
+}
+
+func (a Mutable[T]) Map(f func(T) T) Mutable[T] {
+	Map0Loc(a, f)
+	return a
+}
+
+func (a Mutable[T]) Reverse() Mutable[T] {
+	Reverse0Loc(a)
+	return a
+}
+
+func (a Mutable[T]) Sort(compare func(a, b T) int) Mutable[T] {
+	Sort0Loc(a, compare)
+	return a
+}
+
+func (a Mutable[T]) SortByKey(key Comparer[T]) Mutable[T] {
+	Sort0Loc(a, key.Compare)
+	return a
+}
+
+func (a Mutable[T]) DistinctBy(key func(T) string) Mutable[T] {
+	return DistinctBy0Loc(a, key)
+}
+
+func (a Mutable[T]) Partition(f func(T) bool) (Mutable[T], Mutable[T]) {
+	matched, unmatched := Partition0Loc(a, f)
+	return matched, unmatched
+}
+
+func (a Mutable[T]) Rotate(k int) Mutable[T] {
+	Rotate0Loc(a, k)
+	return a
+}
+
+func (a MutableComparable[T]) Array() Array[T] {
+	return Array[T](a)
+}
+
+func (a MutableComparable[T]) Comparable() ComparableArray[T] {
+	return ComparableArray[T](a)
+}
+
+func (a MutableComparable[T]) Filter(f func(T) bool) MutableComparable[T] {
+	return Filter0Loc(a, f)
+}
+
+func (a MutableComparable[T]) Map(f func(T) T) MutableComparable[T] {
+	Map0Loc(a, f)
+	return a
+}
+
+func (a MutableComparable[T]) Reverse() MutableComparable[T] {
+	Reverse0Loc(a)
+	return a
+}
+
+func (a MutableComparable[T]) Sort(compare func(a, b T) int) MutableComparable[T] {
+	Sort0Loc(a, compare)
+	return a
+}
+
+func (a MutableComparable[T]) SortByKey(key Comparer[T]) MutableComparable[T] {
+	Sort0Loc(a, key.Compare)
+	return a
+}
+
+func (a MutableComparable[T]) DistinctBy(key func(T) string) MutableComparable[T] {
+	return DistinctBy0Loc(a, key)
+}
+
+func (a MutableComparable[T]) Partition(f func(T) bool) (MutableComparable[T], MutableComparable[T]) {
+	matched, unmatched := Partition0Loc(a, f)
+	return matched, unmatched
+}
+
+func (a MutableComparable[T]) Rotate(k int) MutableComparable[T] {
+	Rotate0Loc(a, k)
+	return a
+}
+
+func (a MutableComparable[T]) Unique() MutableComparable[T] {
+	return Unique0Loc(a)
+}
+
+func (a MutableComparable[T]) Compact() MutableComparable[T] {
+	return Compact0Loc(a)
+}
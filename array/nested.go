@@ -0,0 +1,194 @@
+package array
+
+import (
+	"fmt"
+	"reflect"
+)
+
+/* Flatten concatenates the rows of a nested slice into one slice. It is the
+* inverse of Chunk, and accepts the []Array[T] returned by Array.Chunk as well.
+* Example:
+*   chunks := Chunk([]int{1, 2, 3, 4, 5}, 2) // [[1 2] [3 4] [5]]
+*   fmt.Println(Flatten(chunks))             // [1 2 3 4 5]
+ */
+func Flatten[S ~[]T, T any](rows []S) []T {
+	n := 0
+	for _, row := range rows {
+		n += len(row)
+	}
+	flat := make([]T, 0, n)
+	for _, row := range rows {
+		flat = append(flat, row...)
+	}
+
+	return flat
+}
+
+/* FlattenDeep flattens slices nested to any depth, such as [][][]int, into the
+* elements of type T. Nil interface values, such as a nil in a []any, are
+* skipped, while typed nils such as a nil *int are kept as elements of type T.
+* When T is an interface type, such as any, slices and arrays are always
+* flattened rather than taken as a T.
+* It panics if a value that is not a slice or an array is not a T.
+* Example:
+*   cube := [][][]int{{{1, 2}, {3}}, {{4}}}
+*   fmt.Println(FlattenDeep[int](cube))                       // [1 2 3 4]
+*   fmt.Println(FlattenDeep[any]([]any{1, []any{"a", nil}})) // [1 a]
+ */
+func FlattenDeep[T any](nested any) []T {
+	flat := []T{}
+	flattenInterfaces := reflect.TypeFor[T]().Kind() == reflect.Interface
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		if !v.IsValid() {
+			return
+		}
+		kind := v.Kind()
+		if kind == reflect.Interface {
+			walk(v.Elem())
+			return
+		}
+		nestedSlice := kind == reflect.Slice || kind == reflect.Array
+		if !nestedSlice || !flattenInterfaces {
+			if x, ok := v.Interface().(T); ok {
+				flat = append(flat, x)
+				return
+			}
+		}
+		if !nestedSlice {
+			panic(fmt.Sprintf("cannot flatten %s into %s", v.Type(), reflect.TypeFor[T]()))
+		}
+		for i := range v.Len() {
+			walk(v.Index(i))
+		}
+	}
+	walk(reflect.ValueOf(nested))
+
+	return flat
+}
+
+/* Transpose swaps the rows and columns of a matrix. Ragged rows are padded with
+* fill, so the result has one row per column of the longest row, and every row
+* of the result has one element per input row.
+* Example:
+*   rows := [][]int{{1, 2, 3}, {4}}
+*   fmt.Println(Transpose(rows, 0)) // [[1 4] [2 0] [3 0]]
+ */
+func Transpose[S ~[]T, T any](rows []S, fill T) [][]T {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+
+	columns := make([][]T, width)
+	for j := range columns {
+		columns[j] = make([]T, len(rows))
+		for i, row := range rows {
+			if j < len(row) {
+				columns[j][i] = row[j]
+			} else {
+				columns[j][i] = fill
+			}
+		}
+	}
+
+	return columns
+}
+
+/* RotateMatrix rotates a matrix by quarter turns, clockwise for positive turns
+* and counterclockwise for negative ones. Ragged rows are padded with fill.
+* Example:
+*   rows := [][]int{{1, 2}, {3, 4}}
+*   fmt.Println(RotateMatrix(rows, 1, 0))  // [[3 1] [4 2]]
+*   fmt.Println(RotateMatrix(rows, -1, 0)) // [[2 4] [1 3]]
+ */
+func RotateMatrix[S ~[]T, T any](rows []S, turns int, fill T) [][]T {
+	turns = ((turns % 4) + 4) % 4
+	m := Transpose(Transpose(rows, fill), fill)
+	for range turns {
+		// A clockwise quarter turn is the transpose of the rows in reverse order.
+		m = Transpose(Reverse(m), fill)
+	}
+
+	return m
+}
+
+/* Cartesian returns every combination that takes one element from each slice,
+* in lexicographic order. It returns no combination if any slice is empty.
+* Example:
+*   fmt.Println(Cartesian([]int{1, 2}, []int{3, 4})) // [[1 3] [1 4] [2 3] [2 4]]
+ */
+func Cartesian[T any](lists ...[]T) [][]T {
+	if len(lists) == 0 {
+		return [][]T{}
+	}
+	n := 1
+	for _, l := range lists {
+		n *= len(l)
+	}
+
+	product := make([][]T, 0, n)
+	index := make([]int, len(lists))
+	for range n {
+		combination := make([]T, len(lists))
+		for i, l := range lists {
+			combination[i] = l[index[i]]
+		}
+		product = append(product, combination)
+
+		for i := len(index) - 1; i >= 0; i-- {
+			index[i]++
+			if index[i] < len(lists[i]) {
+				break
+			}
+			index[i] = 0
+		}
+	}
+
+	return product
+}
+
+/* Nested is a chain over a slice of arrays, such as the result of Array.Chunk.
+* Example:
+*   rows := array.Nested[int](array.Array[int]{1, 2, 3, 4}.Chunk(2))
+*   fmt.Println(rows.Transpose(0).Flatten()) // [1 3 2 4]
+ */
+type Nested[T any] []Array[T]
+
+// NestedOf starts a Nested chain from rows.
+func NestedOf[S ~[]T, T any](rows ...S) Nested[T] {
+	nested := make(Nested[T], len(rows))
+	for i, row := range rows {
+		nested[i] = Array[T](row)
+	}
+
+	return nested
+}
+
+func (n Nested[T]) Flatten() Array[T] {
+	return Flatten(n)
+}
+
+func (n Nested[T]) Transpose(fill T) Nested[T] {
+	return NestedOf(Transpose(n, fill)...)
+}
+
+// Rotate rotates the matrix by quarter turns; see RotateMatrix.
+func (n Nested[T]) Rotate(turns int, fill T) Nested[T] {
+	return NestedOf(RotateMatrix(n, turns, fill)...)
+}
+
+// Map applies f to every row.
+func (n Nested[T]) Map(f func(Array[T]) Array[T]) Nested[T] {
+	return Map(n, f)
+}
+
+// Rows returns the rows as a [][]T.
+func (n Nested[T]) Rows() [][]T {
+	rows := make([][]T, len(n))
+	for i, row := range n {
+		rows[i] = row
+	}
+
+	return rows
+}
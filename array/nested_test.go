@@ -0,0 +1,131 @@
+package array_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestFlatten(t *testing.T) {
+	t.Run("test inverse of chunk", func(t *testing.T) {
+		a := []int{1, 2, 3, 4, 5}
+		if got := array.Flatten(array.Chunk(a, 2)); !reflect.DeepEqual(got, a) {
+			t.Error("Flatten failed. Got", got, "Expected", a)
+		}
+		chunks := array.Array[int](a).Chunk(3)
+		if got := array.Flatten(chunks); !reflect.DeepEqual(got, a) {
+			t.Error("Flatten failed on Array.Chunk. Got", got)
+		}
+		if got := array.Flatten([][]int{}); got == nil || len(got) != 0 {
+			t.Error("Flatten failed on an empty slice. Got", got)
+		}
+	})
+
+	t.Run("test deep", func(t *testing.T) {
+		cube := [][][]int{{{1, 2}, {3}}, {}, {{4}}}
+		if got := array.FlattenDeep[int](cube); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+			t.Error("FlattenDeep failed. Got", got)
+		}
+		mixed := []any{"a", []string{"b", "c"}, [][]string{{"d"}}}
+		if got := array.FlattenDeep[string](mixed); !reflect.DeepEqual(got, []string{"a", "b", "c", "d"}) {
+			t.Error("FlattenDeep failed on mixed depths. Got", got)
+		}
+		if got := array.FlattenDeep[[]int]([][][]int{{{1}, {2, 3}}}); !reflect.DeepEqual(got, [][]int{{1}, {2, 3}}) {
+			t.Error("FlattenDeep failed to stop at T. Got", got)
+		}
+	})
+
+	t.Run("test deep skips nil", func(t *testing.T) {
+		if got := array.FlattenDeep[int](nil); got == nil || len(got) != 0 {
+			t.Error("FlattenDeep failed on nil. Got", got)
+		}
+		if got := array.FlattenDeep[int]([]any{1, nil, []int(nil), 2}); !reflect.DeepEqual(got, []int{1, 2}) {
+			t.Error("FlattenDeep failed with nil elements. Got", got)
+		}
+		if got := array.FlattenDeep[*int]([]*int{nil}); len(got) != 1 || got[0] != nil {
+			t.Error("FlattenDeep failed with a typed nil. Got", got, "Expected", []*int{nil})
+		}
+	})
+
+	t.Run("test deep into any", func(t *testing.T) {
+		got := array.FlattenDeep[any]([]any{1, []any{"a", nil}, [][]int{{2}}})
+		if !reflect.DeepEqual(got, []any{1, "a", 2}) {
+			t.Error("FlattenDeep[any] failed. Got", got)
+		}
+	})
+
+	t.Run("test deep panics on a foreign leaf", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("FlattenDeep should panic")
+			}
+		}()
+		array.FlattenDeep[int]([]any{1, "two"})
+	})
+}
+
+func TestMatrix(t *testing.T) {
+	t.Run("test transpose", func(t *testing.T) {
+		rows := [][]int{{1, 2, 3}, {4, 5, 6}}
+		if got := array.Transpose(rows, 0); !reflect.DeepEqual(got, [][]int{{1, 4}, {2, 5}, {3, 6}}) {
+			t.Error("Transpose failed. Got", got)
+		}
+		ragged := [][]string{{"a", "b"}, {}, {"c"}}
+		want := [][]string{{"a", "-", "c"}, {"b", "-", "-"}}
+		if got := array.Transpose(ragged, "-"); !reflect.DeepEqual(got, want) {
+			t.Error("Transpose failed on ragged rows. Got", got, "Expected", want)
+		}
+	})
+
+	t.Run("test rotate", func(t *testing.T) {
+		rows := [][]int{{1, 2, 3}, {4, 5, 6}}
+		cases := map[int][][]int{
+			0:  {{1, 2, 3}, {4, 5, 6}},
+			1:  {{4, 1}, {5, 2}, {6, 3}},
+			2:  {{6, 5, 4}, {3, 2, 1}},
+			-1: {{3, 6}, {2, 5}, {1, 4}},
+			5:  {{4, 1}, {5, 2}, {6, 3}},
+		}
+		for turns, want := range cases {
+			if got := array.RotateMatrix(rows, turns, 0); !reflect.DeepEqual(got, want) {
+				t.Error("RotateMatrix failed for", turns, "turns. Got", got, "Expected", want)
+			}
+		}
+		if !reflect.DeepEqual(rows, [][]int{{1, 2, 3}, {4, 5, 6}}) {
+			t.Error("RotateMatrix changed its input. Got", rows)
+		}
+	})
+
+	t.Run("test cartesian", func(t *testing.T) {
+		got := array.Cartesian([]string{"a", "b"}, []string{"x"}, []string{"1", "2"})
+		want := [][]string{{"a", "x", "1"}, {"a", "x", "2"}, {"b", "x", "1"}, {"b", "x", "2"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Error("Cartesian failed. Got", got, "Expected", want)
+		}
+		if got := array.Cartesian([]int{1}, nil); len(got) != 0 {
+			t.Error("Cartesian failed with an empty slice. Got", got)
+		}
+	})
+
+	t.Run("test nested chain", func(t *testing.T) {
+		rows := array.Nested[int](array.Array[int]{1, 2, 3, 4}.Chunk(2))
+		if got := rows.Transpose(0).Flatten(); !reflect.DeepEqual(got, array.Array[int]{1, 3, 2, 4}) {
+			t.Error("Nested.Transpose failed. Got", got)
+		}
+		if got := rows.Rotate(1, 0).Rows(); !reflect.DeepEqual(got, [][]int{{3, 1}, {4, 2}}) {
+			t.Error("Nested.Rotate failed. Got", got)
+		}
+		doubled := rows.Map(func(row array.Array[int]) array.Array[int] { return row.Map(func(n int) int { return n * 2 }) })
+		if got := doubled.Flatten(); !reflect.DeepEqual(got, array.Array[int]{2, 4, 6, 8}) {
+			t.Error("Nested.Map failed. Got", got)
+		}
+		pairs := array.ComparableArray[string]{"a", "b"}.Cartesian([]string{"x"})
+		if got := pairs.Rows(); !reflect.DeepEqual(got, [][]string{{"a", "x"}, {"b", "x"}}) {
+			t.Error("Cartesian chain failed. Got", got)
+		}
+		if got := array.NestedOf([]int{1}, []int{2, 3}).Flatten(); !reflect.DeepEqual(got, array.Array[int]{1, 2, 3}) {
+			t.Error("NestedOf failed. Got", got)
+		}
+	})
+}
@@ -0,0 +1,545 @@
+package array
+
+import (
+	"database/sql/driver"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+/* Numbers is the chain for numeric slices. It has every Array method, returning
+* Numbers instead of Array, plus the numeric helpers that need Number.
+* Convert between the two with Numbers[T](a) and a.Array().
+* Example:
+*   total := Pluck(orders, func(o Order) float64 { return o.Total }).
+*       Filter(func(t float64) bool { return t > 0 }).
+*       Sum()
+ */
+type Numbers[T Number] []T
+
+/* Pluck projects every row to a number and starts a Numbers chain.
+* Example:
+*   prices := Pluck(items, func(item Item) float64 { return item.Price })
+*   fmt.Println(prices.Avg())
+ */
+func Pluck[T any, V Number](w []T, f func(T) V) Numbers[V] {
+	return Map(w, f)
+}
+
+func (a Numbers[T]) Array() Array[T] {
+	return Array[T](a)
+}
+
+func (a Numbers[T]) Sum() T {
+	return Sum(a)
+}
+
+func (a Numbers[T]) Product() T {
+	return Product(a)
+}
+
+func (a Numbers[T]) Avg() float64 {
+	return Avg(a)
+}
+
+func (a Numbers[T]) Min() T {
+	return Min(a)
+}
+
+func (a Numbers[T]) Max() T {
+	return Max(a)
+}
+
+func (a Numbers[T]) SumOption() Option[T] {
+	return SumOption(a)
+}
+
+func (a Numbers[T]) ProductOption() Option[T] {
+	return ProductOption(a)
+}
+
+func (a Numbers[T]) MinOption() Option[T] {
+	return MinOption(a)
+}
+
+func (a Numbers[T]) MaxOption() Option[T] {
+	return MaxOption(a)
+}
+
+func (a Numbers[T]) AvgOption() Option[float64] {
+	return AvgOption(a)
+}
+
+func (a Numbers[T]) Stats() GroupStats[T] {
+	return Stats(a)
+}
+
+// Normalize divides every value by the sum, so that the result sums to 1.
+func (a Numbers[T]) Normalize() Numbers[float64] {
+	total := float64(Sum(a))
+	return Map(a, func(x T) float64 { return float64(x) / total })
+}
+
+// CumSum returns the running total of the values. For the partitioned window
+// function use a.Array().CumSum.
+func (a Numbers[T]) CumSum() Numbers[T] {
+	b := make(Numbers[T], len(a))
+	var total T
+	for i, x := range a {
+		total += x
+		b[i] = total
+	}
+	return b
+}
+
+func (a Numbers[T]) Length() int {
+	return len(a)
+}
+
+func (a Numbers[T]) Filter(f func(T) bool) Numbers[T] {
+	return Filter(a, f)
+}
+
+func (a Numbers[T]) Find(f func(T) bool) T {
+	return Find(a, f)
+}
+
+func (a Numbers[T]) FindOk(f func(T) bool) (T, bool) {
+	return FindOk(a, f)
+}
+
+func (a Numbers[T]) FindOption(f func(T) bool) Option[T] {
+	return FindOption(a, f)
+}
+
+func (a Numbers[T]) Map(f func(T) T) Numbers[T] {
+	return Map(a, f)
+}
+
+func (a Numbers[T]) FlatMap(f func(T) []T) Numbers[T] {
+	return FlatMap(a, f)
+}
+
+func (a Numbers[T]) Reduce(f func(T, T) T) T {
+	return Reduce(a, f)
+}
+
+// ReduceOption is Reduce that returns None for an empty array instead of panicking.
+func (a Numbers[T]) ReduceOption(f func(T, T) T) Option[T] {
+	return ReduceOption(a, f)
+}
+
+func (a Numbers[T]) Reverse() Numbers[T] {
+	return Reverse(a)
+}
+
+func (a Numbers[T]) Any(f func(T) bool) bool {
+	return Any(a, f)
+}
+
+func (a Numbers[T]) Some(f func(T) bool) bool {
+	return Some(a, f)
+}
+
+func (a Numbers[T]) Every(f func(T) bool) bool {
+	return Every(a, f)
+}
+
+func (a Numbers[T]) Shuffle() Numbers[T] {
+	return Shuffle(a)
+}
+
+func (a Numbers[T]) ShuffleWith(r *rand.Rand) Numbers[T] {
+	return ShuffleWith(a, r)
+}
+
+func (a Numbers[T]) Sample(n int, r *rand.Rand) Numbers[T] {
+	return Sample(a, n, r)
+}
+
+func (a Numbers[T]) SampleWithReplacement(n int, r *rand.Rand) Numbers[T] {
+	return SampleWithReplacement(a, n, r)
+}
+
+func (a Numbers[T]) WeightedSample(n int, weight func(T) float64, r *rand.Rand) Numbers[T] {
+	return WeightedSample(a, n, weight, r)
+}
+
+func (a Numbers[T]) ReservoirSample(n int, r *rand.Rand) Numbers[T] {
+	return ReservoirSample(slices.Values(a), n, r)
+}
+
+func (a Numbers[T]) Sort(f func(i, j int) bool) Numbers[T] {
+	return Sort(a, f)
+}
+
+func (a Numbers[T]) Join(sep string) string {
+	return Join(a, sep)
+}
+
+func (a Numbers[T]) Fill(start, end int, x T) Numbers[T] {
+	return Fill(a, start, end, x)
+}
+
+func (a Numbers[T]) Push(x ...T) Numbers[T] {
+	return Push(a, x...)
+}
+
+func (a Numbers[T]) Pop() (T, Numbers[T]) {
+	last, rest := Pop(a)
+	return last, rest
+}
+
+func (a Numbers[T]) Shift() (T, Numbers[T]) {
+	first, rest := Shift(a)
+	return first, rest
+}
+
+func (a Numbers[T]) Unshift(x ...T) Numbers[T] {
+	return Unshift(a, x...)
+}
+
+func (a Numbers[T]) Slice(start, end int) Numbers[T] {
+	return Slice(a, start, end)
+}
+
+func (a Numbers[T]) Splice(start, deleteCount int, items ...T) (Numbers[T], Numbers[T]) {
+	b, removed := Splice(a, start, deleteCount, items...)
+	return b, removed
+}
+
+func (a Numbers[T]) InsertAt(i int, items ...T) Numbers[T] {
+	return InsertAt(a, i, items...)
+}
+
+func (a Numbers[T]) RemoveAt(i int) Numbers[T] {
+	return RemoveAt(a, i)
+}
+
+func (a Numbers[T]) RemoveWhere(f func(T) bool) Numbers[T] {
+	return RemoveWhere(a, f)
+}
+
+func (a Numbers[T]) Union(b []T) Numbers[T] {
+	return Union(a, b)
+}
+
+// ForEach calls f for every element and returns the array, so the chain can go on.
+func (a Numbers[T]) ForEach(f func(T)) Numbers[T] {
+	ForEach(a, f)
+	return a
+}
+
+func (a Numbers[T]) Pluck(f func(T) float64) Numbers[float64] {
+	return Pluck(a, f)
+}
+
+func (a Numbers[T]) GroupBy(f func(T) string) map[string][]T {
+	return GroupBy(a, f)
+}
+
+func (a Numbers[T]) Groups(key func(T) string) []Group[string, T] {
+	return Groups(a, key)
+}
+
+func (a Numbers[T]) ToSetBy(key func(T) string) Set[string] {
+	return ToSetBy(a, key)
+}
+
+func (a Numbers[T]) GroupSumBy(key func(T) string, value func(T) float64) map[string]float64 {
+	return GroupSumBy(a, key, value)
+}
+
+func (a Numbers[T]) GroupSumByWhere(where func(T) bool, key func(T) string, value func(T) float64) map[string]float64 {
+	return GroupSumByWhere(a, where, key, value)
+}
+
+func (a Numbers[T]) GroupCountBy(key func(T) string) map[string]int {
+	return GroupCountBy(a, key)
+}
+
+func (a Numbers[T]) GroupReduceBy(key func(T) string, reduce func(float64, T) float64) map[string]float64 {
+	return GroupReduceBy(a, key, reduce)
+}
+
+func (a Numbers[T]) CounterBy(key func(T) string) Counter[string] {
+	return CounterBy(a, key)
+}
+
+func (a Numbers[T]) GroupStatsBy(key func(T) string, value func(T) float64) map[string]GroupStats[float64] {
+	return GroupStatsBy(a, key, value)
+}
+
+func (a Numbers[T]) GroupByTime(ts func(T) time.Time, bucket TimeBucket) map[time.Time][]T {
+	return GroupByTime(a, ts, bucket)
+}
+
+func (a Numbers[T]) GroupSumByTime(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) map[time.Time]float64 {
+	return GroupSumByTime(a, ts, value, bucket)
+}
+
+func (a Numbers[T]) GroupCountByTime(ts func(T) time.Time, bucket TimeBucket) map[time.Time]int {
+	return GroupCountByTime(a, ts, bucket)
+}
+
+func (a Numbers[T]) GroupStatsByTime(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) map[time.Time]GroupStats[float64] {
+	return GroupStatsByTime(a, ts, value, bucket)
+}
+
+func (a Numbers[T]) GroupByOrdered(f func(T) string) *OrderedMap[string, []T] {
+	return GroupByOrdered(a, f)
+}
+
+func (a Numbers[T]) GroupSumByOrdered(key func(T) string, value func(T) float64) *OrderedMap[string, float64] {
+	return GroupSumByOrdered(a, key, value)
+}
+
+func (a Numbers[T]) GroupSumByWhereOrdered(where func(T) bool, key func(T) string, value func(T) float64) *OrderedMap[string, float64] {
+	return GroupSumByWhereOrdered(a, where, key, value)
+}
+
+func (a Numbers[T]) GroupCountByOrdered(key func(T) string) *OrderedMap[string, int] {
+	return GroupCountByOrdered(a, key)
+}
+
+func (a Numbers[T]) GroupReduceByOrdered(key func(T) string, reduce func(float64, T) float64) *OrderedMap[string, float64] {
+	return GroupReduceByOrdered(a, key, reduce)
+}
+
+func (a Numbers[T]) GroupStatsByOrdered(key func(T) string, value func(T) float64) *OrderedMap[string, GroupStats[float64]] {
+	return GroupStatsByOrdered(a, key, value)
+}
+
+func (a Numbers[T]) IndexByOrdered(key func(T) string) *OrderedMap[string, T] {
+	return IndexByOrdered(a, key)
+}
+
+func (a Numbers[T]) GroupHyperLogLogByOrdered(group func(T) string, key func(T) string, precision uint8) *OrderedMap[string, *HyperLogLog[string]] {
+	return GroupHyperLogLogByOrdered(a, group, key, precision)
+}
+
+func (a Numbers[T]) GroupQuantileSketchByOrdered(key func(T) string, value func(T) float64, k int) *OrderedMap[string, *QuantileSketch[float64]] {
+	return GroupQuantileSketchByOrdered(a, key, value, k)
+}
+
+func (a Numbers[T]) GroupByTimeOrdered(ts func(T) time.Time, bucket TimeBucket) *OrderedMap[time.Time, []T] {
+	return GroupByTimeOrdered(a, ts, bucket)
+}
+
+func (a Numbers[T]) GroupSumByTimeOrdered(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) *OrderedMap[time.Time, float64] {
+	return GroupSumByTimeOrdered(a, ts, value, bucket)
+}
+
+func (a Numbers[T]) GroupCountByTimeOrdered(ts func(T) time.Time, bucket TimeBucket) *OrderedMap[time.Time, int] {
+	return GroupCountByTimeOrdered(a, ts, bucket)
+}
+
+func (a Numbers[T]) GroupStatsByTimeOrdered(ts func(T) time.Time, value func(T) float64, bucket TimeBucket) *OrderedMap[time.Time, GroupStats[float64]] {
+	return GroupStatsByTimeOrdered(a, ts, value, bucket)
+}
+
+func (a Numbers[T]) DistinctBy(key func(T) string) Numbers[T] {
+	return DistinctBy(a, key)
+}
+
+func (a Numbers[T]) IndexBy(key func(T) string) map[string]T {
+	return IndexBy(a, key)
+}
+
+func (a Numbers[T]) Partition(f func(T) bool) (Numbers[T], Numbers[T]) {
+	matched, unmatched := Partition(a, f)
+	return matched, unmatched
+}
+
+func (a Numbers[T]) SortByString(key func(T) string) Numbers[T] {
+	return SortBy(a, key)
+}
+
+func (a Numbers[T]) SortByFloat64(key func(T) float64) Numbers[T] {
+	return SortBy(a, key)
+}
+
+func (a Numbers[T]) SortByKey(key Comparer[T]) Numbers[T] {
+	return sortByComparer(a, key)
+}
+
+func (a Numbers[T]) DistinctByKey(key Keyer[T]) Numbers[T] {
+	return key.DistinctBy(a)
+}
+
+// GroupByKey groups the items by key, in the order their key first appears.
+func (a Numbers[T]) GroupByKey(key Keyer[T]) []Group[any, T] {
+	return key.GroupsAny(a)
+}
+
+// IndexByKey keeps the last item of each key, like IndexBy.
+func (a Numbers[T]) IndexByKey(key Keyer[T]) map[any]T {
+	return key.IndexByAny(a)
+}
+
+func (a Numbers[T]) GroupCountByKey(key Keyer[T]) map[any]int {
+	return key.GroupCountByAny(a)
+}
+
+func (a Numbers[T]) IndexByKeyOrdered(key Keyer[T]) *OrderedMap[any, T] {
+	return key.IndexByAnyOrdered(a)
+}
+
+func (a Numbers[T]) GroupCountByKeyOrdered(key Keyer[T]) *OrderedMap[any, int] {
+	return key.GroupCountByAnyOrdered(a)
+}
+
+// Value stores the array as a JSON array; see SQLArray for other encodings.
+func (a Numbers[T]) Value() (driver.Value, error) {
+	return sqlValue(a, JSONEncoding)
+}
+
+func (a *Numbers[T]) Scan(src any) error {
+	w, err := sqlScan[T](src, JSONEncoding)
+	if err != nil {
+		return err
+	}
+	*a = w
+
+	return nil
+}
+
+// Vector copies the values into an immutable Vector.
+func (a Numbers[T]) Vector() Vector[T] {
+	return NewVector(a...)
+}
+
+// Deque copies the values into a Deque.
+func (a Numbers[T]) Deque() *Deque[T] {
+	return NewDeque(a...)
+}
+
+// Mutable starts the mutable chain on the same memory; see Mutable.
+func (a Numbers[T]) Mutable() Mutable[T] {
+	return Mutable[T](a)
+}
+
+// Heap copies the values into a Heap ordered by compare.
+func (a Numbers[T]) Heap(compare func(a, b T) int) *Heap[T] {
+	return NewHeap(compare, a...)
+}
+
+func (a Numbers[T]) TopK(k int, compare func(a, b T) int) Numbers[T] {
+	return TopK(a, k, compare)
+}
+
+func (a Numbers[T]) TopKBy(k int, key func(T) float64) Numbers[T] {
+	return TopKBy(a, k, key)
+}
+
+// MergeSorted merges the values with others; all of them must be sorted by compare.
+func (a Numbers[T]) MergeSorted(compare func(a, b T) int, others ...[]T) Numbers[T] {
+	return MergeSorted(compare, append([][]T{a}, others...)...)
+}
+
+func (a Numbers[T]) Schedule(workers int, cost func(T) float64) []Numbers[T] {
+	assigned := Schedule(a, workers, cost)
+	result := make([]Numbers[T], len(assigned))
+	for i, w := range assigned {
+		result[i] = w
+	}
+
+	return result
+}
+
+// SortedSlice copies the values into a SortedSlice ordered by key.
+func (a Numbers[T]) SortedSlice(key func(T) float64) *SortedSlice[T, float64] {
+	return NewSortedSlice(key, a...)
+}
+
+func (a Numbers[T]) BinarySearchBy(target float64, key func(T) float64) (int, bool) {
+	return BinarySearchBy(a, target, key)
+}
+
+func (a Numbers[T]) LowerBound(target float64, key func(T) float64) int {
+	return LowerBound(a, target, key)
+}
+
+func (a Numbers[T]) UpperBound(target float64, key func(T) float64) int {
+	return UpperBound(a, target, key)
+}
+
+func (a Numbers[T]) EqualRange(target float64, key func(T) float64) (int, int) {
+	return EqualRange(a, target, key)
+}
+
+func (a Numbers[T]) RangeBy(from, to float64, key func(T) float64) Numbers[T] {
+	return RangeBy(a, from, to, key)
+}
+
+// Cartesian returns every combination of one element of the values and one of each of others.
+func (a Numbers[T]) Cartesian(others ...[]T) Nested[T] {
+	return NestedOf(Cartesian(append([][]T{a}, others...)...)...)
+}
+
+// BuildTree links the values into trees by their IDs; see the BuildTree function.
+func (a Numbers[T]) BuildTree(id func(T) string, parentID func(T) string) (Forest[T], TreeReport[T]) {
+	return BuildTree(a, id, parentID)
+}
+
+func (a Numbers[T]) Take(n int) Numbers[T] {
+	return Take(a, n)
+}
+
+func (a Numbers[T]) Skip(n int) Numbers[T] {
+	return Skip(a, n)
+}
+
+func (a Numbers[T]) Chunk(size int) []Numbers[T] {
+	chunks := Chunk(a, size)
+	result := make([]Numbers[T], len(chunks))
+	for i, chunk := range chunks {
+		result[i] = chunk
+	}
+
+	return result
+}
+
+func (a Numbers[T]) RowNumber(partition func(T) string, order func(T) float64) []WindowRow[T, int] {
+	return RowNumber(a, partition, order)
+}
+
+func (a Numbers[T]) Rank(partition func(T) string, order func(T) float64) []WindowRow[T, int] {
+	return Rank(a, partition, order)
+}
+
+func (a Numbers[T]) DenseRank(partition func(T) string, order func(T) float64) []WindowRow[T, int] {
+	return DenseRank(a, partition, order)
+}
+
+func (a Numbers[T]) PercentRank(partition func(T) string, order func(T) float64) []WindowRow[T, float64] {
+	return PercentRank(a, partition, order)
+}
+
+func (a Numbers[T]) Lag(partition func(T) string, order func(T) float64, value func(T) float64, offset int, fallback float64) []WindowRow[T, float64] {
+	return Lag(a, partition, order, value, offset, fallback)
+}
+
+func (a Numbers[T]) Lead(partition func(T) string, order func(T) float64, value func(T) float64, offset int, fallback float64) []WindowRow[T, float64] {
+	return Lead(a, partition, order, value, offset, fallback)
+}
+
+func (a Numbers[T]) MovingAvg(partition func(T) string, order func(T) float64, value func(T) float64, n int) []WindowRow[T, float64] {
+	return MovingAvg(a, partition, order, value, n)
+}
+
+func (a Numbers[T]) ApproxCountDistinct(key func(T) string) uint64 {
+	return ApproxCountDistinct(a, key)
+}
+
+func (a Numbers[T]) ApproxQuantiles(value func(T) float64, qs ...float64) []float64 {
+	return ApproxQuantiles(a, value, qs...)
+}
+
+func (a Numbers[T]) GroupHyperLogLogBy(group func(T) string, key func(T) string, precision uint8) map[string]*HyperLogLog[string] {
+	return GroupHyperLogLogBy(a, group, key, precision)
+}
+
+func (a Numbers[T]) GroupQuantileSketchBy(key func(T) string, value func(T) float64, k int) map[string]*QuantileSketch[float64] {
+	return GroupQuantileSketchBy(a, key, value, k)
+}
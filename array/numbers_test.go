@@ -0,0 +1,79 @@
+package array_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestNumbers(t *testing.T) {
+	type Order struct {
+		ID    int
+		Price float64
+	}
+
+	orders := []Order{{1, 10}, {2, 30}, {3, 20}, {4, 40}}
+	prices := array.Pluck(orders, func(o Order) float64 { return o.Price })
+
+	t.Run("test Pluck", func(t *testing.T) {
+		if !reflect.DeepEqual(prices, array.Numbers[float64]{10, 30, 20, 40}) {
+			t.Error("Pluck failed. Got", prices)
+		}
+	})
+
+	t.Run("test numeric methods", func(t *testing.T) {
+		if prices.Sum() != 100 {
+			t.Error("Sum failed. Got", prices.Sum(), "Expected", 100)
+		}
+		if prices.Product() != 240000 {
+			t.Error("Product failed. Got", prices.Product(), "Expected", 240000)
+		}
+		if prices.Avg() != 25 {
+			t.Error("Avg failed. Got", prices.Avg(), "Expected", 25)
+		}
+		if prices.Min() != 10 || prices.Max() != 40 {
+			t.Error("Min/Max failed. Got", prices.Min(), prices.Max())
+		}
+		expected := array.GroupStats[float64]{Count: 4, Sum: 100, Min: 10, Max: 40, Avg: 25}
+		if prices.Stats() != expected {
+			t.Error("Stats failed. Got", prices.Stats(), "Expected", expected)
+		}
+		if got := prices.Normalize(); !reflect.DeepEqual(got, array.Numbers[float64]{0.1, 0.3, 0.2, 0.4}) {
+			t.Error("Normalize failed. Got", got)
+		}
+		if got := prices.CumSum(); !reflect.DeepEqual(got, array.Numbers[float64]{10, 40, 60, 100}) {
+			t.Error("CumSum failed. Got", got)
+		}
+	})
+
+	t.Run("test chain keeps Numbers", func(t *testing.T) {
+		total := prices.
+			Filter(func(p float64) bool { return p > 10 }).
+			Map(func(p float64) float64 { return p * 2 }).
+			SortByFloat64(func(p float64) float64 { return p }).
+			Take(2).
+			Sum()
+		if total != 100 {
+			t.Error("chain failed. Got", total, "Expected", 100)
+		}
+
+		high, low := prices.Partition(func(p float64) bool { return p >= 30 })
+		if high.Sum() != 70 || low.Sum() != 30 {
+			t.Error("Partition failed. Got", high, low)
+		}
+
+		chunks := prices.Chunk(3)
+		if len(chunks) != 2 || chunks[1].Max() != 40 {
+			t.Error("Chunk failed. Got", chunks)
+		}
+	})
+
+	t.Run("test conversions", func(t *testing.T) {
+		a := array.Array[int]{3, 1, 2}
+		n := array.Numbers[int](a).Reverse()
+		if n.Max() != 3 || !reflect.DeepEqual(n.Array(), array.Array[int]{2, 1, 3}) {
+			t.Error("conversion failed. Got", n)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package array
+
+/* Option holds a value or nothing. It replaces the zero value that Find returns
+* on a miss, so "not found" and "found the zero value" can be told apart.
+* The zero value is None.
+* Example:
+*   user := FindOption(users, func(u User) bool { return u.ID == id })
+*   name := MapOption(user, func(u User) string { return u.Name }).OrElse("anonymous")
+ */
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// OptionOf returns an Option holding x.
+func OptionOf[T any](x T) Option[T] {
+	return Option[T]{value: x, ok: true}
+}
+
+// None returns an empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// OptionFrom turns a comma-ok pair into an Option.
+func OptionFrom[T any](x T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return OptionOf(x)
+}
+
+// Get returns the value and whether there is one.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Unwrap returns the value. It panics if there is none.
+func (o Option[T]) Unwrap() T {
+	if !o.ok {
+		panic("cannot Unwrap an empty option")
+	}
+	return o.value
+}
+
+// OrElse returns the value, or fallback if there is none.
+func (o Option[T]) OrElse(fallback T) T {
+	if !o.ok {
+		return fallback
+	}
+	return o.value
+}
+
+// Result returns the value as a Result, with err when there is none.
+func (o Option[T]) Result(err error) Result[T] {
+	if !o.ok {
+		return Err[T](err)
+	}
+	return Ok(o.value)
+}
+
+// MapOption applies f to the value of o, if there is one.
+func MapOption[T, U any](o Option[T], f func(T) U) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return OptionOf(f(o.value))
+}
+
+// FlatMapOption applies f to the value of o, if there is one, and returns its result.
+func FlatMapOption[T, U any](o Option[T], f func(T) Option[U]) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return f(o.value)
+}
+
+// FindOption returns the first element that satisfies f, or None.
+func FindOption[T any](a []T, f func(T) bool) Option[T] {
+	return OptionFrom(FindOk(a, f))
+}
+
+// IndexOfOption returns the index of the first occurrence of x, or None.
+func IndexOfOption[T comparable](a []T, x T) Option[int] {
+	i := IndexOf(a, x)
+	return OptionFrom(i, i >= 0)
+}
+
+// ReduceOption is Reduce that returns None for an empty slice instead of panicking.
+func ReduceOption[T any](a []T, f func(T, T) T) Option[T] {
+	if len(a) == 0 {
+		return None[T]()
+	}
+	return OptionOf(Reduce(a, f))
+}
+
+// SumOption returns the sum, or None for an empty slice.
+func SumOption[T Number](a []T) Option[T] {
+	if len(a) == 0 {
+		return None[T]()
+	}
+	return OptionOf(Sum(a))
+}
+
+// ProductOption returns the product, or None for an empty slice.
+func ProductOption[T Number](a []T) Option[T] {
+	if len(a) == 0 {
+		return None[T]()
+	}
+	return OptionOf(Product(a))
+}
+
+// MinOption returns the smallest element, or None for an empty slice.
+func MinOption[T Number](a []T) Option[T] {
+	if len(a) == 0 {
+		return None[T]()
+	}
+	return OptionOf(Min(a))
+}
+
+// MaxOption returns the largest element, or None for an empty slice.
+func MaxOption[T Number](a []T) Option[T] {
+	if len(a) == 0 {
+		return None[T]()
+	}
+	return OptionOf(Max(a))
+}
+
+// AvgOption returns the average, or None for an empty slice.
+func AvgOption[T Number](a []T) Option[float64] {
+	if len(a) == 0 {
+		return None[float64]()
+	}
+	return OptionOf(Avg(a))
+}
@@ -0,0 +1,146 @@
+package array_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestOption(t *testing.T) {
+	t.Run("test get and or else", func(t *testing.T) {
+		some := array.OptionOf(0)
+		if x, ok := some.Get(); x != 0 || !ok || !some.IsSome() {
+			t.Error("OptionOf failed. Got", x, ok)
+		}
+		none := array.None[int]()
+		if none.OrElse(7) != 7 || !none.IsNone() {
+			t.Error("None failed. Got", none.OrElse(7))
+		}
+		var zero array.Option[int]
+		if zero.IsSome() {
+			t.Error("zero Option is not None")
+		}
+		if array.OptionFrom(3, false).IsSome() || array.OptionFrom(3, true).Unwrap() != 3 {
+			t.Error("OptionFrom failed")
+		}
+	})
+
+	t.Run("test map and flat map", func(t *testing.T) {
+		half := func(n int) array.Option[int] { return array.OptionFrom(n/2, n%2 == 0) }
+		if got := array.MapOption(array.OptionOf(2), strconv.Itoa).OrElse(""); got != "2" {
+			t.Error("MapOption failed. Got", got)
+		}
+		if got := array.MapOption(array.None[int](), strconv.Itoa); got.IsSome() {
+			t.Error("MapOption failed on None. Got", got)
+		}
+		if got := array.FlatMapOption(array.OptionOf(4), half).OrElse(-1); got != 2 {
+			t.Error("FlatMapOption failed. Got", got)
+		}
+		if got := array.FlatMapOption(array.OptionOf(3), half); got.IsSome() {
+			t.Error("FlatMapOption failed. Got", got)
+		}
+	})
+
+	t.Run("test lookups", func(t *testing.T) {
+		a := []int{0, 3, 5}
+		if got := array.FindOption(a, func(n int) bool { return n == 0 }); !got.IsSome() {
+			t.Error("FindOption failed to find the zero value")
+		}
+		if got := array.FindOption(a, func(n int) bool { return n > 9 }); got.IsSome() {
+			t.Error("FindOption failed. Got", got)
+		}
+		letters := array.ComparableArray[string]{"a", "b"}
+		if got := letters.IndexOfOption("b"); got.OrElse(-1) != 1 {
+			t.Error("IndexOfOption failed. Got", got)
+		}
+		if got := array.IndexOfOption(a, 9); got.IsSome() {
+			t.Error("IndexOfOption failed. Got", got)
+		}
+	})
+
+	t.Run("test aggregations", func(t *testing.T) {
+		var empty array.Numbers[int]
+		if empty.MinOption().IsSome() || empty.MaxOption().IsSome() || empty.AvgOption().IsSome() {
+			t.Error("aggregations failed on an empty array")
+		}
+		if got := empty.ReduceOption(func(a, b int) int { return a + b }).OrElse(-1); got != -1 {
+			t.Error("ReduceOption failed. Got", got)
+		}
+		n := array.Numbers[int]{4, 1, 7}
+		if n.MinOption().Unwrap() != 1 || n.MaxOption().Unwrap() != 7 || n.AvgOption().Unwrap() != 4 {
+			t.Error("aggregations failed. Got", n.MinOption(), n.MaxOption(), n.AvgOption())
+		}
+		if empty.SumOption().IsSome() || empty.ProductOption().IsSome() {
+			t.Error("SumOption/ProductOption failed on an empty array")
+		}
+		if n.SumOption().Unwrap() != 12 || n.ProductOption().Unwrap() != 28 {
+			t.Error("SumOption/ProductOption failed. Got", n.SumOption(), n.ProductOption())
+		}
+	})
+
+	t.Run("test unwrap panics", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Unwrap should panic")
+			}
+		}()
+		array.None[int]().Unwrap()
+	})
+}
+
+func TestResult(t *testing.T) {
+	errBad := errors.New("bad")
+
+	t.Run("test result of", func(t *testing.T) {
+		ok := array.ResultOf(strconv.Atoi("12"))
+		if x, good := ok.Get(); x != 12 || !good || !ok.IsOk() {
+			t.Error("ResultOf failed. Got", x, good)
+		}
+		failed := array.ResultOf(strconv.Atoi("x"))
+		if failed.IsOk() || failed.Err() == nil || failed.OrElse(-1) != -1 {
+			t.Error("ResultOf failed. Got", failed)
+		}
+		if _, err := failed.Unpack(); err == nil {
+			t.Error("Unpack lost the error")
+		}
+	})
+
+	t.Run("test map and flat map", func(t *testing.T) {
+		parse := func(s string) array.Result[int] { return array.ResultOf(strconv.Atoi(s)) }
+		if got := array.FlatMapResult(array.Ok("42"), parse).Unwrap(); got != 42 {
+			t.Error("FlatMapResult failed. Got", got)
+		}
+		mapped := array.MapResult(array.Err[int](errBad), strconv.Itoa)
+		if !errors.Is(mapped.Err(), errBad) {
+			t.Error("MapResult lost the error. Got", mapped.Err())
+		}
+		if got := array.MapResult(array.Ok(3), strconv.Itoa).Unwrap(); got != "3" {
+			t.Error("MapResult failed. Got", got)
+		}
+	})
+
+	t.Run("test option conversions", func(t *testing.T) {
+		if got := array.Err[int](errBad).Option(); got.IsSome() {
+			t.Error("Option failed. Got", got)
+		}
+		r := array.None[int]().Result(errBad)
+		if !errors.Is(r.Err(), errBad) {
+			t.Error("Result failed. Got", r)
+		}
+		if got := array.OptionOf(1).Result(errBad); !reflect.DeepEqual(got, array.Ok(1)) {
+			t.Error("Result failed. Got", got)
+		}
+	})
+
+	t.Run("test unwrap panics with the error", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != errBad {
+				t.Error("Unwrap failed. Got", r)
+			}
+		}()
+		array.Err[int](errBad).Unwrap()
+	})
+}
@@ -0,0 +1,132 @@
+package array
+
+import (
+	"slices"
+	"time"
+)
+
+/* The Ordered variants return the same entries as the map-returning functions,
+* in an OrderedMap: keys come in the order they first appear in the input, and
+* time buckets come in chronological order.
+* Example:
+*   byCustomer := GroupSumByOrdered(orders,
+*       func(o Order) string { return o.Customer },
+*       func(o Order) float64 { return o.Total },
+*   )
+*   out, _ := json.Marshal(byCustomer) // customers in the order of the orders
+ */
+
+// orderByFirst returns the entries of m in the order their key first appears in w.
+func orderByFirst[T any, K comparable, V any](w []T, key func(T) K, m map[K]V) *OrderedMap[K, V] {
+	om := NewOrderedMap[K, V]()
+	for _, x := range w {
+		if om.Len() == len(m) {
+			break
+		}
+		k := key(x)
+		if v, ok := m[k]; ok && !om.Has(k) {
+			om.Set(k, v)
+		}
+	}
+
+	return om
+}
+
+// orderByTime returns the entries of m sorted by time.
+func orderByTime[V any](m map[time.Time]V) *OrderedMap[time.Time, V] {
+	keys := make([]time.Time, 0, len(m))
+	for t := range m {
+		keys = append(keys, t)
+	}
+	slices.SortFunc(keys, time.Time.Compare)
+
+	om := NewOrderedMap[time.Time, V]()
+	for _, t := range keys {
+		om.Set(t, m[t])
+	}
+
+	return om
+}
+
+func identity[T any](x T) T {
+	return x
+}
+
+func FrequenciesOrdered[T comparable](a []T) *OrderedMap[T, int] {
+	return orderByFirst(a, identity[T], Frequencies(a))
+}
+
+func GroupByOrdered[T any, K comparable](w []T, key func(T) K) *OrderedMap[K, []T] {
+	return orderByFirst(w, key, GroupBy(w, key))
+}
+
+func GroupSumByOrdered[T any, K comparable, V Number](w []T, key func(T) K, value func(T) V) *OrderedMap[K, V] {
+	return orderByFirst(w, key, GroupSumBy(w, key, value))
+}
+
+func GroupSumByWhereOrdered[T any, K comparable, V Number](w []T, where func(T) bool, key func(T) K, value func(T) V) *OrderedMap[K, V] {
+	return orderByFirst(Filter(w, where), key, GroupSumByWhere(w, where, key, value))
+}
+
+func GroupCountByOrdered[T any, K comparable](w []T, key func(T) K) *OrderedMap[K, int] {
+	return orderByFirst(w, key, GroupCountBy(w, key))
+}
+
+func GroupReduceByOrdered[T any, K comparable, A any](w []T, key func(T) K, reduce func(A, T) A) *OrderedMap[K, A] {
+	return orderByFirst(w, key, GroupReduceBy(w, key, reduce))
+}
+
+func GroupStatsByOrdered[T any, K comparable, V Number](w []T, key func(T) K, value func(T) V) *OrderedMap[K, GroupStats[V]] {
+	return orderByFirst(w, key, GroupStatsBy(w, key, value))
+}
+
+// MergeGroupStatsOrdered is MergeGroupStats for ordered maps; keys keep the
+// order in which they first appear across the maps.
+func MergeGroupStatsOrdered[K comparable, V Number](maps ...*OrderedMap[K, GroupStats[V]]) *OrderedMap[K, GroupStats[V]] {
+	m := NewOrderedMap[K, GroupStats[V]]()
+	for _, stats := range maps {
+		for k, s := range stats.All() {
+			merged, _ := m.Get(k)
+			merged.Merge(s)
+			m.Set(k, merged)
+		}
+	}
+
+	return m
+}
+
+// IndexByOrdered keeps the position of the first element of each key and the
+// value of the last one, like IndexBy.
+func IndexByOrdered[T any, K comparable](w []T, key func(T) K) *OrderedMap[K, T] {
+	return orderByFirst(w, key, IndexBy(w, key))
+}
+
+func GroupHyperLogLogByOrdered[T any, G comparable, K comparable](w []T, group func(T) G, key func(T) K, precision uint8) *OrderedMap[G, *HyperLogLog[K]] {
+	return orderByFirst(w, group, GroupHyperLogLogBy(w, group, key, precision))
+}
+
+func GroupQuantileSketchByOrdered[T any, K comparable, V Number](w []T, key func(T) K, value func(T) V, k int) *OrderedMap[K, *QuantileSketch[V]] {
+	return orderByFirst(w, key, GroupQuantileSketchBy(w, key, value, k))
+}
+
+func GroupByTimeOrdered[T any](w []T, ts func(T) time.Time, bucket TimeBucket) *OrderedMap[time.Time, []T] {
+	return orderByTime(GroupByTime(w, ts, bucket))
+}
+
+func GroupSumByTimeOrdered[T any, V Number](w []T, ts func(T) time.Time, value func(T) V, bucket TimeBucket) *OrderedMap[time.Time, V] {
+	return orderByTime(GroupSumByTime(w, ts, value, bucket))
+}
+
+func GroupCountByTimeOrdered[T any](w []T, ts func(T) time.Time, bucket TimeBucket) *OrderedMap[time.Time, int] {
+	return orderByTime(GroupCountByTime(w, ts, bucket))
+}
+
+func GroupStatsByTimeOrdered[T any, V Number](w []T, ts func(T) time.Time, value func(T) V, bucket TimeBucket) *OrderedMap[time.Time, GroupStats[V]] {
+	return orderByTime(GroupStatsByTime(w, ts, value, bucket))
+}
+
+// SubtreeStatsOrdered keeps the nodes in depth-first order, parents before
+// their children.
+func SubtreeStatsOrdered[T any, K comparable, V Number](roots Forest[T], id func(T) K, value func(T) V) *OrderedMap[K, GroupStats[V]] {
+	return orderByFirst(roots.Values(), id, SubtreeStats(roots, id, value))
+}
@@ -0,0 +1,260 @@
+package array
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+/* OrderedMap is a map that remembers the order in which keys were first set.
+* Lookups, Set and Delete are O(1); iteration and JSON encoding follow the key
+* order. Setting an existing key changes its value but keeps its position.
+* The zero value is an empty map ready to use.
+* Example:
+*   m := NewOrderedMap[string, int]()
+*   m.Set("b", 2)
+*   m.Set("a", 1)
+*   for k, v := range m.All() {
+*       fmt.Println(k, v) // b 2, then a 1
+*   }
+ */
+type OrderedMap[K comparable, V any] struct {
+	index      map[K]*orderedEntry[K, V]
+	head, tail *orderedEntry[K, V]
+}
+
+type orderedEntry[K comparable, V any] struct {
+	prev, next *orderedEntry[K, V]
+	key        K
+	value      V
+}
+
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{index: make(map[K]*orderedEntry[K, V])}
+}
+
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.index)
+}
+
+func (m *OrderedMap[K, V]) Get(k K) (V, bool) {
+	if e, ok := m.index[k]; ok {
+		return e.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (m *OrderedMap[K, V]) Has(k K) bool {
+	_, ok := m.index[k]
+	return ok
+}
+
+// Set sets the value of k, adding k at the back if it is new.
+func (m *OrderedMap[K, V]) Set(k K, v V) {
+	if m.index == nil {
+		m.index = make(map[K]*orderedEntry[K, V])
+	}
+	if e, ok := m.index[k]; ok {
+		e.value = v
+		return
+	}
+	e := &orderedEntry[K, V]{key: k, value: v}
+	m.index[k] = e
+	m.pushBack(e)
+}
+
+// Delete removes k and reports whether it was present.
+func (m *OrderedMap[K, V]) Delete(k K) bool {
+	e, ok := m.index[k]
+	if !ok {
+		return false
+	}
+	delete(m.index, k)
+	m.unlink(e)
+	return true
+}
+
+// MoveToFront moves k to the first position and reports whether it was present.
+func (m *OrderedMap[K, V]) MoveToFront(k K) bool {
+	e, ok := m.index[k]
+	if !ok {
+		return false
+	}
+	m.unlink(e)
+	m.pushFront(e)
+	return true
+}
+
+// MoveToBack moves k to the last position and reports whether it was present.
+func (m *OrderedMap[K, V]) MoveToBack(k K) bool {
+	e, ok := m.index[k]
+	if !ok {
+		return false
+	}
+	m.unlink(e)
+	m.pushBack(e)
+	return true
+}
+
+// Front returns the first key and its value; ok is false when the map is empty.
+func (m *OrderedMap[K, V]) Front() (k K, v V, ok bool) {
+	if m.Len() == 0 {
+		return k, v, false
+	}
+	return m.head.key, m.head.value, true
+}
+
+// Back returns the last key and its value; ok is false when the map is empty.
+func (m *OrderedMap[K, V]) Back() (k K, v V, ok bool) {
+	if m.Len() == 0 {
+		return k, v, false
+	}
+	return m.tail.key, m.tail.value, true
+}
+
+func (m *OrderedMap[K, V]) pushFront(e *orderedEntry[K, V]) {
+	e.next = m.head
+	if m.head != nil {
+		m.head.prev = e
+	} else {
+		m.tail = e
+	}
+	m.head = e
+}
+
+func (m *OrderedMap[K, V]) pushBack(e *orderedEntry[K, V]) {
+	e.prev = m.tail
+	if m.tail != nil {
+		m.tail.next = e
+	} else {
+		m.head = e
+	}
+	m.tail = e
+}
+
+func (m *OrderedMap[K, V]) unlink(e *orderedEntry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		m.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		m.tail = e.prev
+	}
+	e.prev, e.next = nil, nil
+}
+
+// All iterates over the keys and values in order. Deleting the current key
+// during iteration is allowed.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := m.head; e != nil; {
+			next := e.next
+			if !yield(e.key, e.value) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func (m *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Map copies the entries into a plain map.
+func (m *OrderedMap[K, V]) Map() map[K]V {
+	result := make(map[K]V, m.Len())
+	for k, v := range m.All() {
+		result[k] = v
+	}
+	return result
+}
+
+// MarshalJSON writes the map as a JSON object with the keys in order. Keys that
+// do not encode as JSON strings, such as numbers, are quoted.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	i := 0
+	for k, v := range m.All() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		i++
+		key, err := marshalJSONKey(k)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON reads a JSON object and keeps the keys in the order of the document.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		// Like encoding/json, a null document leaves the map unchanged.
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("cannot unmarshal %v into an ordered map", tok)
+	}
+
+	result := NewOrderedMap[K, V]()
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		k, err := unmarshalJSONKey[K](tok.(string))
+		if err != nil {
+			return err
+		}
+		var v V
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		result.Set(k, v)
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	*m = *result
+
+	return nil
+}
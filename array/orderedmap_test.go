@@ -0,0 +1,198 @@
+package array_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestOrderedMap(t *testing.T) {
+	t.Run("test set, get and delete", func(t *testing.T) {
+		m := array.NewOrderedMap[string, int]()
+		m.Set("b", 2)
+		m.Set("a", 1)
+		m.Set("c", 3)
+		m.Set("b", 20)
+		if v, ok := m.Get("b"); !ok || v != 20 {
+			t.Error("Get failed. Got", v, ok)
+		}
+		if !m.Delete("a") || m.Delete("a") || m.Has("a") {
+			t.Error("Delete failed")
+		}
+		if got := slices.Collect(m.Keys()); !reflect.DeepEqual(got, []string{"b", "c"}) {
+			t.Error("Keys failed. Got", got, "Expected", []string{"b", "c"})
+		}
+		if got := slices.Collect(m.Values()); !reflect.DeepEqual(got, []int{20, 3}) {
+			t.Error("Values failed. Got", got, "Expected", []int{20, 3})
+		}
+	})
+
+	t.Run("test move", func(t *testing.T) {
+		m := array.NewOrderedMap[int, string]()
+		for i, s := range []string{"a", "b", "c"} {
+			m.Set(i, s)
+		}
+		m.MoveToFront(2)
+		m.MoveToBack(1)
+		if m.MoveToFront(9) {
+			t.Error("MoveToFront failed on a missing key")
+		}
+		if got := slices.Collect(m.Keys()); !reflect.DeepEqual(got, []int{2, 0, 1}) {
+			t.Error("Move failed. Got", got, "Expected", []int{2, 0, 1})
+		}
+		if k, v, ok := m.Front(); !ok || k != 2 || v != "c" {
+			t.Error("Front failed. Got", k, v, ok)
+		}
+		if k, _, ok := m.Back(); !ok || k != 1 {
+			t.Error("Back failed. Got", k, ok)
+		}
+	})
+
+	t.Run("test delete while iterating", func(t *testing.T) {
+		m := array.NewOrderedMap[int, int]()
+		for i := range 5 {
+			m.Set(i, i)
+		}
+		for k := range m.All() {
+			if k%2 == 0 {
+				m.Delete(k)
+			}
+		}
+		if got := slices.Collect(m.Keys()); !reflect.DeepEqual(got, []int{1, 3}) {
+			t.Error("Delete during All failed. Got", got)
+		}
+	})
+
+	t.Run("test zero value", func(t *testing.T) {
+		var m array.OrderedMap[string, int]
+		if _, _, ok := m.Front(); ok || m.Len() != 0 || m.Has("a") {
+			t.Error("zero value is not empty")
+		}
+		m.Set("a", 1)
+		if got := m.Map(); !reflect.DeepEqual(got, map[string]int{"a": 1}) {
+			t.Error("Set on the zero value failed. Got", got)
+		}
+	})
+
+	t.Run("test json", func(t *testing.T) {
+		m := array.NewOrderedMap[int, string]()
+		m.Set(10, "x")
+		m.Set(2, "y")
+		data, err := json.Marshal(m)
+		if err != nil || string(data) != `{"10":"x","2":"y"}` {
+			t.Error("MarshalJSON failed. Got", string(data), err)
+		}
+
+		var back array.OrderedMap[int, string]
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Error("UnmarshalJSON failed.", err)
+		}
+		if got := slices.Collect(back.Keys()); !reflect.DeepEqual(got, []int{10, 2}) {
+			t.Error("UnmarshalJSON failed. Got", got, "Expected", []int{10, 2})
+		}
+
+		type report struct {
+			Totals array.OrderedMap[string, int]
+			Counts *array.OrderedMap[string, int]
+		}
+		var r report
+		r.Totals.Set("z", 1)
+		r.Totals.Set("a", 2)
+		data, err = json.Marshal(r)
+		if err != nil || string(data) != `{"Totals":{"z":1,"a":2},"Counts":null}` {
+			t.Error("MarshalJSON failed on a struct field. Got", string(data), err)
+		}
+		var decoded report
+		if err := json.Unmarshal(data, &decoded); err != nil || decoded.Totals.Len() != 2 {
+			t.Error("UnmarshalJSON failed on a struct field. Got", decoded, err)
+		}
+
+		var names array.OrderedMap[string, int]
+		if err := json.Unmarshal([]byte(`{"z":1,"a":2}`), &names); err != nil {
+			t.Error("UnmarshalJSON failed.", err)
+		}
+		if got := slices.Collect(names.Keys()); !reflect.DeepEqual(got, []string{"z", "a"}) {
+			t.Error("UnmarshalJSON failed. Got", got, "Expected", []string{"z", "a"})
+		}
+		if err := json.Unmarshal([]byte(`[1]`), &names); err == nil {
+			t.Error("UnmarshalJSON accepted an array")
+		}
+
+		control := array.NewOrderedMap[string, int]()
+		control.Set("a\x01b", 1)
+		control.Set("x\vy", 2)
+		control.Set("q\"t", 3)
+		data, err = json.Marshal(control)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decodedControl array.OrderedMap[string, int]
+		if err := json.Unmarshal(data, &decodedControl); err != nil {
+			t.Error("UnmarshalJSON failed on control characters.", err)
+		}
+		if got := slices.Collect(decodedControl.Keys()); !reflect.DeepEqual(got, []string{"a\x01b", "x\vy", "q\"t"}) {
+			t.Error("UnmarshalJSON failed on control characters. Got", got)
+		}
+
+		if err := json.Unmarshal([]byte(`null`), &names); err != nil || names.Len() != 2 {
+			t.Error("UnmarshalJSON failed on null. Got", names.Len(), err)
+		}
+		var fromNull report
+		if err := json.Unmarshal([]byte(`{"Totals":null,"Counts":null}`), &fromNull); err != nil || fromNull.Totals.Len() != 0 || fromNull.Counts != nil {
+			t.Error("UnmarshalJSON failed on a null field. Got", fromNull, err)
+		}
+	})
+}
+
+func TestOrderedVariants(t *testing.T) {
+	orders := []member{{"Bob", 10}, {"Ann", 5}, {"Bob", 1}, {"Cid", 7}}
+	name := func(m member) string { return m.Name }
+	age := func(m member) int { return m.Age }
+
+	t.Run("test first appearance order", func(t *testing.T) {
+		sums := array.GroupSumByOrdered(orders, name, age)
+		if got := slices.Collect(sums.Keys()); !reflect.DeepEqual(got, []string{"Bob", "Ann", "Cid"}) {
+			t.Error("GroupSumByOrdered failed. Got", got)
+		}
+		if v, _ := sums.Get("Bob"); v != 11 {
+			t.Error("GroupSumByOrdered failed. Got", v, "Expected", 11)
+		}
+
+		freq := array.FrequenciesOrdered([]string{"b", "a", "b"})
+		data, _ := json.Marshal(freq)
+		if string(data) != `{"b":2,"a":1}` {
+			t.Error("FrequenciesOrdered failed. Got", string(data))
+		}
+	})
+
+	t.Run("test group sum by where", func(t *testing.T) {
+		sums := array.GroupSumByWhereOrdered(orders, func(m member) bool { return m.Age < 8 }, name, age)
+		if got := slices.Collect(sums.Keys()); !reflect.DeepEqual(got, []string{"Ann", "Bob", "Cid"}) {
+			t.Error("GroupSumByWhereOrdered failed. Got", got)
+		}
+	})
+
+	t.Run("test time order", func(t *testing.T) {
+		at := []time.Time{
+			time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+		}
+		counts := array.GroupCountByTimeOrdered(at, func(t time.Time) time.Time { return t }, array.DayBucket(time.UTC))
+		keys := slices.Collect(counts.Keys())
+		if len(keys) != 3 || !slices.IsSortedFunc(keys, time.Time.Compare) {
+			t.Error("GroupCountByTimeOrdered failed. Got", keys)
+		}
+	})
+
+	t.Run("test chain", func(t *testing.T) {
+		groups := array.Array[member](orders).GroupByOrdered(name)
+		if _, first, _ := groups.Front(); len(first) != 2 {
+			t.Error("GroupByOrdered failed. Got", first)
+		}
+	})
+}
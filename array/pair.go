@@ -0,0 +1,21 @@
+package array
+
+/* Pair holds two values. PairOf wraps a call that returns two values, so the
+* pair can travel as one value through a pipeline or a slice.
+* Example:
+*   p := PairOf(Partition(orders, isPaid))
+*   paid, unpaid := p.Unpack()
+ */
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+func PairOf[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Unpack returns the two values, as a function call would.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
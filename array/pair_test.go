@@ -0,0 +1,19 @@
+package array_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestPair(t *testing.T) {
+	p := array.PairOf(array.Partition([]int{1, 2, 3, 4}, func(n int) bool { return n%2 == 0 }))
+	even, odd := p.Unpack()
+	if !reflect.DeepEqual(even, []int{2, 4}) || !reflect.DeepEqual(odd, []int{1, 3}) {
+		t.Error("PairOf failed. Got", even, odd)
+	}
+	if p.First[0] != 2 || p.Second[0] != 1 {
+		t.Error("Pair fields failed. Got", p)
+	}
+}
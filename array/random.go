@@ -0,0 +1,179 @@
+package array
+
+import (
+	"cmp"
+	"iter"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+/* The functions below take the random generator as their last argument, so
+* shuffles and samples can be reproduced: the same seed gives the same result.
+* A nil generator uses the global source, like Shuffle. A *rand.Rand is not
+* safe for concurrent use, so do not share one between goroutines.
+* Example:
+*   r := NewRand(42)
+*   fixture := ShuffleWith(users, r)
+*   group := Sample(users, 100, NewRand(experimentSeed))
+ */
+
+// NewRand returns a generator seeded with seed.
+func NewRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+}
+
+func randIntN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
+
+func randFloat64(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.Float64()
+	}
+	return r.Float64()
+}
+
+// ShuffleWith returns a shuffled copy of the slice, drawing from r.
+func ShuffleWith[T any](a []T, r *rand.Rand) []T {
+	b := slices.Clone(a)
+	for i := len(b) - 1; i > 0; i-- {
+		j := randIntN(r, i+1)
+		b[i], b[j] = b[j], b[i]
+	}
+	return b
+}
+
+/* Sample picks n distinct elements in random order. It returns every element,
+* shuffled, when n is larger than the slice, and an empty slice when n <= 0.
+* Example:
+*   a := []int{1, 2, 3, 4, 5}
+*   fmt.Println(Sample(a, 2, NewRand(1))) // two of the values, e.g. [4 1]
+ */
+func Sample[T any](a []T, n int, r *rand.Rand) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	n = min(n, len(a))
+
+	b := slices.Clone(a)
+	for i := 0; i < n; i++ {
+		j := i + randIntN(r, len(b)-i)
+		b[i], b[j] = b[j], b[i]
+	}
+	return b[:n:n]
+}
+
+// SampleWithReplacement picks n elements, each one independently, so the same
+// element can be picked more than once. It panics if the slice is empty and n > 0.
+func SampleWithReplacement[T any](a []T, n int, r *rand.Rand) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	if len(a) == 0 {
+		panic("cannot sample from an empty slice")
+	}
+
+	b := make([]T, n)
+	for i := range b {
+		b[i] = a[randIntN(r, len(a))]
+	}
+	return b
+}
+
+/* WeightedSample picks n distinct elements, where the chance of each pick is
+* proportional to weight. Elements with a weight <= 0 are never picked, so the
+* result is shorter than n when fewer elements have a positive weight.
+* The result is in pick order: heavier elements tend to come first.
+* Example:
+*   ads := WeightedSample(candidates, 3, func(a Ad) float64 { return a.Bid }, nil)
+ */
+func WeightedSample[T any](a []T, n int, weight func(T) float64, r *rand.Rand) []T {
+	if n <= 0 {
+		return []T{}
+	}
+
+	// Efraimidis-Spirakis: give every element the key log(u)/w and keep the
+	// n largest keys.
+	type keyed struct {
+		x   T
+		key float64
+	}
+	candidates := make([]keyed, 0, len(a))
+	for _, x := range a {
+		w := weight(x)
+		if w <= 0 || math.IsNaN(w) {
+			continue
+		}
+		u := 1 - randFloat64(r)
+		candidates = append(candidates, keyed{x, math.Log(u) / w})
+	}
+	slices.SortStableFunc(candidates, func(p, q keyed) int {
+		return cmp.Compare(q.key, p.key)
+	})
+
+	n = min(n, len(candidates))
+	b := make([]T, n)
+	for i := range b {
+		b[i] = candidates[i].x
+	}
+	return b
+}
+
+/* Reservoir keeps a uniform sample of at most n elements from a stream of
+* unknown length, using O(n) memory. Add the elements one by one and read the
+* sample at any time with Items.
+* Example:
+*   res := NewReservoir[LogLine](1000, NewRand(7))
+*   for line := range lines {
+*       res.Add(line)
+*   }
+*   sample := res.Items()
+ */
+type Reservoir[T any] struct {
+	n     int
+	seen  int
+	items []T
+	rand  *rand.Rand
+}
+
+func NewReservoir[T any](n int, r *rand.Rand) *Reservoir[T] {
+	if n < 0 {
+		panic("reservoir size must not be negative")
+	}
+	return &Reservoir[T]{n: n, items: make([]T, 0, n), rand: r}
+}
+
+func (s *Reservoir[T]) Add(x T) {
+	s.seen++
+	if len(s.items) < s.n {
+		s.items = append(s.items, x)
+		return
+	}
+	if j := randIntN(s.rand, s.seen); j < s.n {
+		s.items[j] = x
+	}
+}
+
+// Seen returns the number of elements added so far.
+func (s *Reservoir[T]) Seen() int {
+	return s.seen
+}
+
+// Items returns a copy of the current sample.
+func (s *Reservoir[T]) Items() []T {
+	return slices.Clone(s.items)
+}
+
+// ReservoirSample reads the whole sequence and returns a uniform sample of at
+// most n of its elements.
+func ReservoirSample[T any](seq iter.Seq[T], n int, r *rand.Rand) []T {
+	s := NewReservoir[T](n, r)
+	for x := range seq {
+		s.Add(x)
+	}
+	return s.items
+}
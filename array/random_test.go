@@ -0,0 +1,158 @@
+package array_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestShuffleWith(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	t.Run("test same seed same order", func(t *testing.T) {
+		b := array.ShuffleWith(a, array.NewRand(42))
+		c := array.ShuffleWith(a, array.NewRand(42))
+		if !reflect.DeepEqual(b, c) {
+			t.Error("ShuffleWith failed. Got", b, "and", c)
+		}
+		if reflect.DeepEqual(b, array.ShuffleWith(a, array.NewRand(43))) {
+			t.Error("ShuffleWith should depend on the seed. Got", b)
+		}
+		sorted := slices.Clone(b)
+		slices.Sort(sorted)
+		if !reflect.DeepEqual(sorted, a) {
+			t.Error("ShuffleWith lost elements. Got", b)
+		}
+	})
+
+	t.Run("test chain and nil rand", func(t *testing.T) {
+		b := array.Array[int](a).ShuffleWith(array.NewRand(1))
+		if !reflect.DeepEqual(b, array.Array[int](array.ShuffleWith(a, array.NewRand(1)))) {
+			t.Error("ShuffleWith failed. Got", b)
+		}
+		if len(array.ShuffleWith(a, nil)) != len(a) {
+			t.Error("ShuffleWith failed with a nil rand")
+		}
+	})
+}
+
+func TestSample(t *testing.T) {
+	a := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	t.Run("test without replacement", func(t *testing.T) {
+		b := array.Sample(a, 4, array.NewRand(7))
+		if len(b) != 4 || len(array.Unique(b)) != 4 {
+			t.Error("Sample failed. Got", b)
+		}
+		if !reflect.DeepEqual(b, array.Sample(a, 4, array.NewRand(7))) {
+			t.Error("Sample is not reproducible. Got", b)
+		}
+		if got := array.Sample(a, 20, nil); len(got) != 10 {
+			t.Error("Sample failed. Got", got)
+		}
+		if got := array.Sample(a, -1, nil); len(got) != 0 {
+			t.Error("Sample failed. Got", got)
+		}
+	})
+
+	t.Run("test uniform", func(t *testing.T) {
+		r := array.NewRand(3)
+		counts := map[int]int{}
+		for i := 0; i < 10000; i++ {
+			for _, x := range array.Sample(a, 3, r) {
+				counts[x]++
+			}
+		}
+		for _, x := range a {
+			if counts[x] < 2700 || counts[x] > 3300 {
+				t.Error("Sample is not uniform. Got", counts)
+				break
+			}
+		}
+	})
+
+	t.Run("test with replacement", func(t *testing.T) {
+		b := array.ComparableArray[int](a[:2]).SampleWithReplacement(50, array.NewRand(5))
+		if len(b) != 50 || b.Count(1)+b.Count(2) != 50 || b.Count(1) == 0 || b.Count(2) == 0 {
+			t.Error("SampleWithReplacement failed. Got", b)
+		}
+		defer func() {
+			if recover() == nil {
+				t.Error("SampleWithReplacement should panic on an empty slice")
+			}
+		}()
+		array.SampleWithReplacement([]int{}, 1, nil)
+	})
+}
+
+func TestWeightedSample(t *testing.T) {
+	type ad struct {
+		Name string
+		Bid  float64
+	}
+	ads := []ad{{"a", 1}, {"b", 9}, {"c", 0}, {"d", -1}}
+	bid := func(x ad) float64 { return x.Bid }
+
+	t.Run("test weights", func(t *testing.T) {
+		r := array.NewRand(11)
+		first := map[string]int{}
+		for i := 0; i < 5000; i++ {
+			got := array.WeightedSample(ads, 1, bid, r)
+			first[got[0].Name]++
+		}
+		if first["c"] != 0 || first["d"] != 0 || first["b"] < 4300 || first["b"] > 4700 {
+			t.Error("WeightedSample failed. Got", first)
+		}
+	})
+
+	t.Run("test only positive weights", func(t *testing.T) {
+		got := array.Array[ad](ads).WeightedSample(4, bid, array.NewRand(1))
+		if len(got) != 2 {
+			t.Error("WeightedSample failed. Got", got)
+		}
+	})
+}
+
+func TestReservoir(t *testing.T) {
+	t.Run("test uniform over a stream", func(t *testing.T) {
+		r := array.NewRand(9)
+		stream := make([]int, 20)
+		for i := range stream {
+			stream[i] = i
+		}
+		counts := make([]int, 20)
+		for i := 0; i < 5000; i++ {
+			for _, x := range array.ReservoirSample(slices.Values(stream), 5, r) {
+				counts[x]++
+			}
+		}
+		for x, n := range counts {
+			if n < 1100 || n > 1400 {
+				t.Error("Reservoir is not uniform at", x, "Got", counts)
+				break
+			}
+		}
+	})
+
+	t.Run("test short stream", func(t *testing.T) {
+		got := array.ReservoirSample(slices.Values([]string{"a", "b"}), 5, array.NewRand(1))
+		if !reflect.DeepEqual(got, []string{"a", "b"}) {
+			t.Error("ReservoirSample failed. Got", got)
+		}
+		s := array.NewReservoir[string](0, nil)
+		s.Add("a")
+		if len(s.Items()) != 0 || s.Seen() != 1 {
+			t.Error("Reservoir failed. Got", s.Items(), s.Seen())
+		}
+	})
+
+	t.Run("test chain", func(t *testing.T) {
+		exp := array.ReservoirSample(slices.Values([]int{1, 2, 3, 4, 5}), 2, array.NewRand(3))
+		got := array.Numbers[int]{1, 2, 3, 4, 5}.ReservoirSample(2, array.NewRand(3))
+		if !reflect.DeepEqual([]int(got), exp) {
+			t.Error("ReservoirSample failed. Got", got, "Expected", exp)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package array
+
+/* Result holds a value or the error that prevented it. ResultOf turns the
+* (T, error) pair of a function call or a pipe stage into one, so it can be
+* mapped over and stored in slices.
+* Example:
+*   total := ResultOf(pipe.Sum[int]()(values))
+*   label := MapResult(total, strconv.Itoa).OrElse("n/a")
+ */
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding x.
+func Ok[T any](x T) Result[T] {
+	return Result[T]{value: x}
+}
+
+// Err returns a failed Result. It panics if err is nil.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("Err requires a non-nil error")
+	}
+	return Result[T]{err: err}
+}
+
+// ResultOf turns a (value, error) pair into a Result.
+func ResultOf[T any](x T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(x)
+}
+
+// Get returns the value and whether the Result succeeded.
+func (r Result[T]) Get() (T, bool) {
+	return r.value, r.err == nil
+}
+
+// Unpack returns the value and the error, as a function call would.
+func (r Result[T]) Unpack() (T, error) {
+	return r.value, r.err
+}
+
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Unwrap returns the value. It panics with the error if the Result failed.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// OrElse returns the value, or fallback if the Result failed.
+func (r Result[T]) OrElse(fallback T) T {
+	if r.err != nil {
+		return fallback
+	}
+	return r.value
+}
+
+// Option returns the value as an Option, dropping the error.
+func (r Result[T]) Option() Option[T] {
+	return OptionFrom(r.Get())
+}
+
+// MapResult applies f to the value of r, if it succeeded.
+func MapResult[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(f(r.value))
+}
+
+// FlatMapResult applies f to the value of r, if it succeeded, and returns its result.
+func FlatMapResult[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return f(r.value)
+}
@@ -0,0 +1,148 @@
+package array
+
+import (
+	"bytes"
+	"encoding/json"
+	"iter"
+	"maps"
+	"slices"
+)
+
+/* Set is a set of comparable values. It is a map, so len, range and delete
+* work on it directly, and its Has method can be passed as a predicate.
+* Add and Remove change the set; Union, Intersect and Difference return a new one.
+* Example:
+*   banned := ToSet(bannedIDs)
+*   active := Filter(ids, func(id int) bool { return !banned.Has(id) })
+*   admins := Filter(ids, NewSet(1, 2).Has)
+ */
+type Set[T comparable] map[T]struct{}
+
+func NewSet[T comparable](items ...T) Set[T] {
+	return ToSet(items)
+}
+
+// ToSet builds a set from the elements of the slice.
+func ToSet[T comparable](w []T) Set[T] {
+	s := make(Set[T], len(w))
+	for _, x := range w {
+		s[x] = struct{}{}
+	}
+
+	return s
+}
+
+// ToSetBy builds the set of the keys of the elements.
+func ToSetBy[T any, K comparable](w []T, key func(T) K) Set[K] {
+	s := make(Set[K], len(w))
+	for _, x := range w {
+		s[key(x)] = struct{}{}
+	}
+
+	return s
+}
+
+func (s Set[T]) Add(items ...T) {
+	for _, x := range items {
+		s[x] = struct{}{}
+	}
+}
+
+func (s Set[T]) Remove(items ...T) {
+	for _, x := range items {
+		delete(s, x)
+	}
+}
+
+func (s Set[T]) Has(x T) bool {
+	_, ok := s[x]
+	return ok
+}
+
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+func (s Set[T]) Union(others ...Set[T]) Set[T] {
+	u := maps.Clone(s)
+	if u == nil {
+		u = Set[T]{}
+	}
+	for _, other := range others {
+		maps.Copy(u, other)
+	}
+
+	return u
+}
+
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	u := Set[T]{}
+	for x := range small {
+		if large.Has(x) {
+			u[x] = struct{}{}
+		}
+	}
+
+	return u
+}
+
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	u := Set[T]{}
+	for x := range s {
+		if !other.Has(x) {
+			u[x] = struct{}{}
+		}
+	}
+
+	return u
+}
+
+// Values iterates over the elements in no particular order.
+func (s Set[T]) Values() iter.Seq[T] {
+	return maps.Keys(s)
+}
+
+/* Sorted iterates over the elements in the order given by compare.
+* Example:
+*   for id := range ids.Sorted(cmp.Compare[int]) {
+*       fmt.Println(id)
+*   }
+ */
+func (s Set[T]) Sorted(compare func(a, b T) int) iter.Seq[T] {
+	return slices.Values(slices.SortedFunc(maps.Keys(s), compare))
+}
+
+// MarshalJSON writes the set as a JSON array, sorted by the JSON encoding of the
+// elements so the output is deterministic.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	items := make([][]byte, 0, len(s))
+	for x := range s {
+		b, err := json.Marshal(x)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, b)
+	}
+	slices.SortFunc(items, bytes.Compare)
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	buf.Write(bytes.Join(items, []byte{','}))
+	buf.WriteByte(']')
+
+	return buf.Bytes(), nil
+}
+
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*s = ToSet(items)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package array_test
+
+import (
+	"cmp"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestSet(t *testing.T) {
+	t.Run("test add, remove and has", func(t *testing.T) {
+		s := array.NewSet(1, 2, 2, 3)
+		s.Add(4, 1)
+		s.Remove(2, 9)
+		if s.Len() != 3 || !s.Has(1) || s.Has(2) || !s.Has(4) {
+			t.Error("Set failed. Got", s)
+		}
+	})
+
+	t.Run("test set operations", func(t *testing.T) {
+		a := array.NewSet(1, 2, 3)
+		b := array.NewSet(2, 3, 4)
+		sorted := func(s array.Set[int]) []int { return slices.Collect(s.Sorted(cmp.Compare[int])) }
+
+		if got := sorted(a.Union(b, array.NewSet(9))); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 9}) {
+			t.Error("Union failed. Got", got)
+		}
+		if got := sorted(a.Intersect(b)); !reflect.DeepEqual(got, []int{2, 3}) {
+			t.Error("Intersect failed. Got", got)
+		}
+		if got := sorted(a.Difference(b)); !reflect.DeepEqual(got, []int{1}) {
+			t.Error("Difference failed. Got", got)
+		}
+		if got := sorted(a); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+			t.Error("set operations changed the receiver. Got", got)
+		}
+
+		var empty array.Set[int]
+		if got := empty.Union(a); got.Len() != 3 {
+			t.Error("Union failed on a nil set. Got", got)
+		}
+	})
+
+	t.Run("test has as predicate", func(t *testing.T) {
+		allowed := array.NewSet("a", "c")
+		if got := array.Filter([]string{"a", "b", "c"}, allowed.Has); !reflect.DeepEqual(got, []string{"a", "c"}) {
+			t.Error("Filter with Has failed. Got", got)
+		}
+		letters := array.Array[string]{"a", "b"}
+		if got := letters.RemoveWhere(allowed.Has); !reflect.DeepEqual(got, array.Array[string]{"b"}) {
+			t.Error("RemoveWhere with Has failed. Got", got)
+		}
+	})
+
+	t.Run("test constructors", func(t *testing.T) {
+		ids := array.ComparableArray[int]{3, 1, 3}
+		if got := ids.ToSet(); !reflect.DeepEqual(got, array.NewSet(1, 3)) {
+			t.Error("ToSet failed. Got", got)
+		}
+		names := array.Array[member]{{"Ann", 30}, {"Bob", 25}, {"Ann", 20}}
+		if got := names.ToSetBy(func(m member) string { return m.Name }); !reflect.DeepEqual(got, array.NewSet("Ann", "Bob")) {
+			t.Error("ToSetBy failed. Got", got)
+		}
+		if got := array.ToSetBy(names, func(m member) int { return m.Age }); got.Len() != 3 {
+			t.Error("ToSetBy failed. Got", got)
+		}
+	})
+
+	t.Run("test json", func(t *testing.T) {
+		data, err := json.Marshal(array.NewSet("b", "c", "a"))
+		if err != nil || string(data) != `["a","b","c"]` {
+			t.Error("MarshalJSON failed. Got", string(data), err)
+		}
+
+		var s array.Set[int]
+		if err := json.Unmarshal([]byte(`[3,1,3]`), &s); err != nil || !reflect.DeepEqual(s, array.NewSet(1, 3)) {
+			t.Error("UnmarshalJSON failed. Got", s, err)
+		}
+	})
+
+	t.Run("test values", func(t *testing.T) {
+		got := slices.Sorted(array.NewSet(2, 1).Values())
+		if !reflect.DeepEqual(got, []int{1, 2}) {
+			t.Error("Values failed. Got", got)
+		}
+	})
+}
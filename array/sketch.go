@@ -0,0 +1,420 @@
+package array
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+)
+
+const (
+	DefaultHyperLogLogPrecision = 14
+	DefaultQuantileSketchK      = 200
+)
+
+/* HyperLogLog estimates the number of distinct keys in bounded memory.
+* A sketch with precision p uses 2^p bytes and has a relative standard error of
+* about 1.04 / sqrt(2^p): 1.6% for p = 12 (4 KiB), 0.81% for p = 14 (16 KiB)
+* and 0.41% for p = 16 (64 KiB). Estimates stay within three standard errors in
+* more than 99% of the cases.
+*
+* Keys are hashed deterministically (strings and numbers directly, other types
+* through their %#v formatting), so sketches built in different chunks,
+* goroutines or processes can be merged.
+*
+* Example:
+*   h := NewHyperLogLog[string](14)
+*   for _, e := range events {
+*       h.Add(e.UserID)
+*   }
+*   fmt.Println(h.Count())
+ */
+type HyperLogLog[K comparable] struct {
+	precision uint8
+	registers []uint8
+}
+
+func NewHyperLogLog[K comparable](precision uint8) *HyperLogLog[K] {
+	if precision < 4 || precision > 18 {
+		panic("hyperloglog precision must be between 4 and 18")
+	}
+
+	return &HyperLogLog[K]{precision: precision, registers: make([]uint8, 1<<precision)}
+}
+
+func (h *HyperLogLog[K]) Precision() uint8 {
+	return h.precision
+}
+
+func (h *HyperLogLog[K]) Add(k K) {
+	x := hashKey(k)
+	i := x >> (64 - h.precision)
+	rho := uint8(bits.LeadingZeros64(x<<h.precision|1<<(h.precision-1))) + 1
+	if rho > h.registers[i] {
+		h.registers[i] = rho
+	}
+}
+
+// Count returns the estimated number of distinct keys added to the sketch.
+func (h *HyperLogLog[K]) Count() uint64 {
+	m := float64(len(h.registers))
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	estimate := hllAlpha(m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return uint64(estimate + 0.5)
+}
+
+// Merge adds the keys counted by other to h. Both sketches need the same precision.
+func (h *HyperLogLog[K]) Merge(other *HyperLogLog[K]) error {
+	if h.precision != other.precision {
+		return fmt.Errorf("cannot merge hyperloglog with precision %d into precision %d", other.precision, h.precision)
+	}
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+
+	return nil
+}
+
+func hllAlpha(m float64) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+
+	return 0.7213 / (1 + 1.079/m)
+}
+
+func hashKey[K comparable](k K) uint64 {
+	switch v := any(k).(type) {
+	case string:
+		return mix64(fnvString(v))
+	case int:
+		return mix64(uint64(v))
+	case int8:
+		return mix64(uint64(v))
+	case int16:
+		return mix64(uint64(v))
+	case int32:
+		return mix64(uint64(v))
+	case int64:
+		return mix64(uint64(v))
+	case uint:
+		return mix64(uint64(v))
+	case uint8:
+		return mix64(uint64(v))
+	case uint16:
+		return mix64(uint64(v))
+	case uint32:
+		return mix64(uint64(v))
+	case uint64:
+		return mix64(v)
+	case float32:
+		return mix64(uint64(math.Float32bits(v)))
+	case float64:
+		return mix64(math.Float64bits(v))
+	}
+
+	return mix64(fnvString(fmt.Sprintf("%#v", k)))
+}
+
+func fnvString(s string) uint64 {
+	f := fnv.New64a()
+	f.Write([]byte(s))
+	return f.Sum64()
+}
+
+// mix64 is the splitmix64 finalizer; it spreads the input over all 64 bits.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+/* ApproxCountDistinct estimates the number of distinct keys with a HyperLogLog
+* sketch of DefaultHyperLogLogPrecision (16 KiB, about 0.81% standard error).
+* Example:
+*   users := ApproxCountDistinct(events, func(e Event) string { return e.UserID })
+ */
+func ApproxCountDistinct[T any, K comparable](w []T, key func(T) K) uint64 {
+	h := NewHyperLogLog[K](DefaultHyperLogLogPrecision)
+	for _, x := range w {
+		h.Add(key(x))
+	}
+
+	return h.Count()
+}
+
+// GroupHyperLogLogBy builds one HyperLogLog sketch per group. Sketches of the same
+// group built from different chunks can be combined with Merge.
+func GroupHyperLogLogBy[T any, G comparable, K comparable](w []T, group func(T) G, key func(T) K, precision uint8) map[G]*HyperLogLog[K] {
+	m := make(map[G]*HyperLogLog[K])
+	for _, x := range w {
+		g := group(x)
+		h, ok := m[g]
+		if !ok {
+			h = NewHyperLogLog[K](precision)
+			m[g] = h
+		}
+		h.Add(key(x))
+	}
+
+	return m
+}
+
+/* QuantileSketch is a KLL sketch that estimates quantiles in bounded memory.
+* It keeps about 3k values whatever the input size. The rank error is
+* O(1/k) and independent of the input size: with k = 200 a quantile query is
+* off by less than 1.65% of the rank (for example p50 answers a value whose
+* rank is between p48.35 and p51.65) with 99% confidence. Min and max are exact.
+*
+* Sketches with the same k can be merged, and the merged sketch has the same
+* error bound as a sketch built over all the data.
+*
+* Example:
+*   s := NewQuantileSketch[float64](200)
+*   for _, r := range requests {
+*       s.Add(r.Latency)
+*   }
+*   fmt.Println(s.Quantile(0.5), s.Quantile(0.99))
+ */
+type QuantileSketch[V Number] struct {
+	k          int
+	count      int
+	min, max   V
+	compactors [][]V
+	rand       *rand.Rand
+}
+
+// NewQuantileSketch returns a sketch whose compactions draw from the global
+// random source, so independent sketches make independent choices.
+func NewQuantileSketch[V Number](k int) *QuantileSketch[V] {
+	return NewQuantileSketchWith[V](k, nil)
+}
+
+// NewQuantileSketchWith returns a sketch whose compactions draw from r, so the
+// estimates can be reproduced from a seed. A nil r uses the global source.
+func NewQuantileSketchWith[V Number](k int, r *rand.Rand) *QuantileSketch[V] {
+	if k < 8 {
+		panic("quantile sketch k must be at least 8")
+	}
+
+	return &QuantileSketch[V]{
+		k:          k,
+		compactors: make([][]V, 1),
+		rand:       r,
+	}
+}
+
+// Count returns the number of values added to the sketch.
+func (s *QuantileSketch[V]) Count() int {
+	return s.count
+}
+
+func (s *QuantileSketch[V]) Add(v V) {
+	if s.count == 0 || v < s.min {
+		s.min = v
+	}
+	if s.count == 0 || v > s.max {
+		s.max = v
+	}
+	s.count++
+	s.compactors[0] = append(s.compactors[0], v)
+	s.compress()
+}
+
+// Merge adds the values summarized by other to s. Both sketches need the same k.
+func (s *QuantileSketch[V]) Merge(other *QuantileSketch[V]) error {
+	if s.k != other.k {
+		return fmt.Errorf("cannot merge quantile sketch with k %d into k %d", other.k, s.k)
+	}
+	if other.count == 0 {
+		return nil
+	}
+	if s.count == 0 || other.min < s.min {
+		s.min = other.min
+	}
+	if s.count == 0 || other.max > s.max {
+		s.max = other.max
+	}
+	s.count += other.count
+	for len(s.compactors) < len(other.compactors) {
+		s.compactors = append(s.compactors, nil)
+	}
+	for h, c := range other.compactors {
+		s.compactors[h] = append(s.compactors[h], c...)
+	}
+	s.compress()
+
+	return nil
+}
+
+// capacity follows the KLL rule: the top level holds k values and each level
+// below holds two thirds of the level above it.
+func (s *QuantileSketch[V]) capacity(level int) int {
+	depth := len(s.compactors) - level - 1
+	return int(math.Ceil(math.Pow(2.0/3.0, float64(depth))*float64(s.k))) + 1
+}
+
+func (s *QuantileSketch[V]) size() (size, limit int) {
+	for h, c := range s.compactors {
+		size += len(c)
+		limit += s.capacity(h)
+	}
+
+	return size, limit
+}
+
+func (s *QuantileSketch[V]) compress() {
+	for size, limit := s.size(); size >= limit; size, limit = s.size() {
+		for h := range s.compactors {
+			if len(s.compactors[h]) < s.capacity(h) {
+				continue
+			}
+			if h+1 == len(s.compactors) {
+				s.compactors = append(s.compactors, nil)
+			}
+
+			// Sort the level and promote every other value, starting at a
+			// random offset, so that each promoted value weighs twice as much.
+			level := s.compactors[h]
+			slices.Sort(level)
+			offset := randIntN(s.rand, 2)
+			pairs := len(level) / 2
+			for i := 0; i < pairs; i++ {
+				s.compactors[h+1] = append(s.compactors[h+1], level[2*i+offset])
+			}
+			s.compactors[h] = append(level[:0], level[2*pairs:]...)
+			break
+		}
+	}
+}
+
+type weighted[V Number] struct {
+	value  V
+	weight int
+}
+
+func (s *QuantileSketch[V]) sorted() []weighted[V] {
+	var items []weighted[V]
+	for h, c := range s.compactors {
+		for _, v := range c {
+			items = append(items, weighted[V]{v, 1 << h})
+		}
+	}
+	slices.SortFunc(items, func(a, b weighted[V]) int {
+		switch {
+		case a.value < b.value:
+			return -1
+		case a.value > b.value:
+			return 1
+		}
+		return 0
+	})
+
+	return items
+}
+
+// Quantile returns the estimated value at quantile q, between 0 and 1.
+// It returns the zero value when the sketch is empty.
+func (s *QuantileSketch[V]) Quantile(q float64) V {
+	if s.count == 0 {
+		var zero V
+		return zero
+	}
+	if q <= 0 {
+		return s.min
+	}
+	if q >= 1 {
+		return s.max
+	}
+
+	items := s.sorted()
+	total := 0
+	for _, it := range items {
+		total += it.weight
+	}
+	target := q * float64(total)
+	cumulative := 0
+	for _, it := range items {
+		cumulative += it.weight
+		if float64(cumulative) >= target {
+			return it.value
+		}
+	}
+
+	return s.max
+}
+
+// Rank returns the estimated fraction of values that are lower than or equal to v.
+func (s *QuantileSketch[V]) Rank(v V) float64 {
+	if s.count == 0 {
+		return 0
+	}
+
+	below, total := 0, 0
+	for h, c := range s.compactors {
+		for _, x := range c {
+			total += 1 << h
+			if x <= v {
+				below += 1 << h
+			}
+		}
+	}
+
+	return float64(below) / float64(total)
+}
+
+/* ApproxQuantiles estimates the given quantiles with a QuantileSketch of
+* DefaultQuantileSketchK.
+* Example:
+*   p := ApproxQuantiles(requests, func(r Request) float64 { return r.Latency }, 0.5, 0.95, 0.99)
+*   fmt.Println(p) // [p50 p95 p99]
+ */
+func ApproxQuantiles[T any, V Number](w []T, value func(T) V, qs ...float64) []V {
+	s := NewQuantileSketch[V](DefaultQuantileSketchK)
+	for _, x := range w {
+		s.Add(value(x))
+	}
+
+	return Map(qs, s.Quantile)
+}
+
+// GroupQuantileSketchBy builds one QuantileSketch per group. Sketches of the same
+// group built from different chunks can be combined with Merge.
+func GroupQuantileSketchBy[T any, K comparable, V Number](w []T, key func(T) K, value func(T) V, k int) map[K]*QuantileSketch[V] {
+	m := make(map[K]*QuantileSketch[V])
+	for _, x := range w {
+		g := key(x)
+		s, ok := m[g]
+		if !ok {
+			s = NewQuantileSketch[V](k)
+			m[g] = s
+		}
+		s.Add(value(x))
+	}
+
+	return m
+}
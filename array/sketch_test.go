@@ -0,0 +1,168 @@
+package array_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	const distinct = 100000
+
+	h := array.NewHyperLogLog[string](14)
+	for i := 0; i < distinct; i++ {
+		h.Add(fmt.Sprintf("user-%d", i))
+		h.Add(fmt.Sprintf("user-%d", i/2))
+	}
+	if got := relativeError(h.Count(), distinct); got > 0.03 {
+		t.Error("HyperLogLog failed. Got", h.Count(), "Expected about", distinct)
+	}
+
+	t.Run("Merge", func(t *testing.T) {
+		a := array.NewHyperLogLog[int](12)
+		b := array.NewHyperLogLog[int](12)
+		for i := 0; i < 20000; i++ {
+			a.Add(i)
+			b.Add(i + 10000)
+		}
+		if err := a.Merge(b); err != nil {
+			t.Fatal(err)
+		}
+		if got := relativeError(a.Count(), 30000); got > 0.05 {
+			t.Error("Merge failed. Got", a.Count(), "Expected about", 30000)
+		}
+		if err := a.Merge(array.NewHyperLogLog[int](10)); err == nil {
+			t.Error("Merge failed. Expected error for different precision")
+		}
+	})
+
+	t.Run("small cardinality", func(t *testing.T) {
+		got := array.ApproxCountDistinct([]int{1, 2, 3, 2, 1}, func(x int) int { return x })
+		if got != 3 {
+			t.Error("ApproxCountDistinct failed. Got", got, "Expected", 3)
+		}
+	})
+
+	t.Run("GroupHyperLogLogBy", func(t *testing.T) {
+		type visit struct {
+			Page string
+			User int
+		}
+		var visits []visit
+		for i := 0; i < 1000; i++ {
+			visits = append(visits, visit{"home", i}, visit{"about", i % 10})
+		}
+		page := func(v visit) string { return v.Page }
+		user := func(v visit) int { return v.User }
+
+		first := array.GroupHyperLogLogBy(visits[:1000], page, user, 12)
+		second := array.GroupHyperLogLogBy(visits[1000:], page, user, 12)
+		for k, h := range second {
+			if err := first[k].Merge(h); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got := relativeError(first["home"].Count(), 1000); got > 0.05 {
+			t.Error("GroupHyperLogLogBy failed. Got", first["home"].Count(), "Expected about", 1000)
+		}
+		if first["about"].Count() != 10 {
+			t.Error("GroupHyperLogLogBy failed. Got", first["about"].Count(), "Expected", 10)
+		}
+	})
+}
+
+func TestQuantileSketch(t *testing.T) {
+	const n = 100000
+
+	s := array.NewQuantileSketchWith[int](200, array.NewRand(1))
+	for i := 0; i < n; i++ {
+		s.Add((i * 7919) % n) // every value of [0, n) in scrambled order
+	}
+	if s.Count() != n {
+		t.Error("Count failed. Got", s.Count(), "Expected", n)
+	}
+	for _, q := range []float64{0.01, 0.25, 0.5, 0.9, 0.99} {
+		got := s.Quantile(q)
+		if math.Abs(float64(got)/n-q) > 0.0165 {
+			t.Error("Quantile failed for", q, "Got", got, "Expected about", q*n)
+		}
+	}
+	if s.Quantile(0) != 0 || s.Quantile(1) != n-1 {
+		t.Error("Quantile failed. Got", s.Quantile(0), s.Quantile(1), "Expected exact min and max")
+	}
+	if r := s.Rank(n / 4); math.Abs(r-0.25) > 0.0165 {
+		t.Error("Rank failed. Got", r, "Expected about", 0.25)
+	}
+
+	t.Run("Merge", func(t *testing.T) {
+		a := array.NewQuantileSketchWith[float64](200, array.NewRand(2))
+		b := array.NewQuantileSketchWith[float64](200, array.NewRand(3))
+		for i := 0; i < n; i++ {
+			if i%3 == 0 {
+				a.Add(float64(i))
+				continue
+			}
+			b.Add(float64(i))
+		}
+		if err := a.Merge(b); err != nil {
+			t.Error("Merge failed.", err)
+		}
+		if a.Count() != n {
+			t.Error("Merge failed. Got count", a.Count(), "Expected", n)
+		}
+		if got := a.Quantile(0.5); math.Abs(got/n-0.5) > 0.0165 {
+			t.Error("Merge failed. Got median", got, "Expected about", n/2)
+		}
+		other := array.NewQuantileSketch[float64](100)
+		other.Add(1)
+		if err := a.Merge(other); err == nil || a.Count() != n {
+			t.Error("Merge failed. Expected error for different k")
+		}
+	})
+
+	t.Run("seeded", func(t *testing.T) {
+		a := array.NewQuantileSketchWith[int](8, array.NewRand(4))
+		b := array.NewQuantileSketchWith[int](8, array.NewRand(4))
+		for i := 0; i < n; i++ {
+			a.Add((i * 7919) % n)
+			b.Add((i * 7919) % n)
+		}
+		if a.Quantile(0.5) != b.Quantile(0.5) {
+			t.Error("NewQuantileSketchWith failed. Got", a.Quantile(0.5), b.Quantile(0.5), "Expected the same median")
+		}
+	})
+
+	t.Run("ApproxQuantiles", func(t *testing.T) {
+		values := make([]float64, 1000)
+		for i := range values {
+			values[i] = float64(i + 1)
+		}
+		got := array.ApproxQuantiles(values, func(x float64) float64 { return x }, 0.5, 0.9)
+		if math.Abs(got[0]-500) > 16.5 || math.Abs(got[1]-900) > 16.5 {
+			t.Error("ApproxQuantiles failed. Got", got, "Expected about", []float64{500, 900})
+		}
+	})
+
+	t.Run("GroupQuantileSketchBy", func(t *testing.T) {
+		sketches := array.GroupQuantileSketchBy([]int{1, 2, 3, 10, 20, 30},
+			func(x int) bool { return x >= 10 },
+			func(x int) int { return x },
+			200,
+		)
+		if sketches[true].Quantile(0.5) != 20 || sketches[false].Quantile(0.5) != 2 {
+			t.Error("GroupQuantileSketchBy failed. Got", sketches[true].Quantile(0.5), sketches[false].Quantile(0.5))
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if got := array.NewQuantileSketch[int](200).Quantile(0.5); got != 0 {
+			t.Error("Quantile failed. Got", got, "Expected", 0)
+		}
+	})
+}
+
+func relativeError(got uint64, expected float64) float64 {
+	return math.Abs(float64(got)-expected) / expected
+}
@@ -0,0 +1,200 @@
+package array
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+/* BinarySearchBy searches a slice sorted by key for an element with the target
+* key. It returns the position of the first such element and true, or the
+* position where one would be inserted and false.
+* Example:
+*   a := []Order{{ID: 1}, {ID: 4}, {ID: 9}}
+*   i, ok := BinarySearchBy(a, 4, func(o Order) int { return o.ID })
+*   fmt.Println(i, ok) // 1 true
+ */
+func BinarySearchBy[T any, K cmp.Ordered](a []T, target K, key func(T) K) (int, bool) {
+	return binarySearchBy(a, target, key, cmp.Compare[K])
+}
+
+// LowerBound returns the index of the first element whose key is not less than
+// target, in a slice sorted by key.
+func LowerBound[T any, K cmp.Ordered](a []T, target K, key func(T) K) int {
+	return lowerBound(a, target, key, cmp.Compare[K])
+}
+
+// UpperBound returns the index of the first element whose key is greater than
+// target, in a slice sorted by key.
+func UpperBound[T any, K cmp.Ordered](a []T, target K, key func(T) K) int {
+	return upperBound(a, target, key, cmp.Compare[K])
+}
+
+/* EqualRange returns the bounds [lo, hi) of the elements whose key equals target,
+* in a slice sorted by key. The range is empty when there are none.
+* Example:
+*   a := []int{1, 2, 2, 2, 3}
+*   lo, hi := EqualRange(a, 2, func(n int) int { return n })
+*   fmt.Println(lo, hi) // 1 4
+ */
+func EqualRange[T any, K cmp.Ordered](a []T, target K, key func(T) K) (int, int) {
+	return lowerBound(a, target, key, cmp.Compare[K]), upperBound(a, target, key, cmp.Compare[K])
+}
+
+/* RangeBy returns a copy of the elements whose key is between from and to, both
+* included, in a slice sorted by key.
+* Example:
+*   march := RangeBy(orders, "2024-03-01", "2024-03-31", func(o Order) string { return o.Date })
+ */
+func RangeBy[T any, K cmp.Ordered](a []T, from, to K, key func(T) K) []T {
+	return rangeBy(a, from, to, key, cmp.Compare[K])
+}
+
+func binarySearchBy[T, K any](a []T, target K, key func(T) K, compare func(a, b K) int) (int, bool) {
+	return slices.BinarySearchFunc(a, target, func(x T, t K) int { return compare(key(x), t) })
+}
+
+func lowerBound[T, K any](a []T, target K, key func(T) K, compare func(a, b K) int) int {
+	i, _ := binarySearchBy(a, target, key, compare)
+	return i
+}
+
+func upperBound[T, K any](a []T, target K, key func(T) K, compare func(a, b K) int) int {
+	i, _ := slices.BinarySearchFunc(a, target, func(x T, t K) int {
+		if compare(key(x), t) <= 0 {
+			return -1
+		}
+		return 1
+	})
+	return i
+}
+
+func rangeBy[T, K any](a []T, from, to K, key func(T) K, compare func(a, b K) int) []T {
+	lo := lowerBound(a, from, key, compare)
+	hi := max(lo, upperBound(a, to, key, compare))
+	return slices.Clone(a[lo:hi])
+}
+
+/* SortedSlice keeps its elements sorted by key as they are inserted and removed,
+* so lookups and range queries are binary searches. Elements with equal keys
+* keep the order in which they were inserted.
+* Example:
+*   orders := NewSortedSliceFunc(func(o Order) time.Time { return o.At }, time.Time.Compare, all...)
+*   orders.Insert(Order{ID: 7, At: now})
+*   q1 := orders.Range(jan1, mar31)
+ */
+type SortedSlice[T any, K any] struct {
+	items   []T
+	key     func(T) K
+	compare func(a, b K) int
+}
+
+// NewSortedSlice sorts a copy of items by key.
+func NewSortedSlice[T any, K cmp.Ordered](key func(T) K, items ...T) *SortedSlice[T, K] {
+	return NewSortedSliceFunc(key, cmp.Compare[K], items...)
+}
+
+// NewSortedSliceFunc sorts a copy of items by key, comparing keys with compare.
+// It serves keys that are not cmp.Ordered, such as time.Time.
+func NewSortedSliceFunc[T any, K any](key func(T) K, compare func(a, b K) int, items ...T) *SortedSlice[T, K] {
+	s := &SortedSlice[T, K]{items: slices.Clone(items), key: key, compare: compare}
+	slices.SortStableFunc(s.items, s.compareItems)
+	return s
+}
+
+func (s *SortedSlice[T, K]) compareItems(a, b T) int {
+	return s.compare(s.key(a), s.key(b))
+}
+
+func (s *SortedSlice[T, K]) Len() int {
+	return len(s.items)
+}
+
+// At returns the element at index i. It panics if i is out of range.
+func (s *SortedSlice[T, K]) At(i int) T {
+	return s.items[i]
+}
+
+// Insert adds x in key order, after the elements with an equal key.
+func (s *SortedSlice[T, K]) Insert(x ...T) {
+	for _, e := range x {
+		s.items = slices.Insert(s.items, upperBound(s.items, s.key(e), s.key, s.compare), e)
+	}
+}
+
+// Remove removes the elements with key k and returns how many there were.
+func (s *SortedSlice[T, K]) Remove(k K) int {
+	lo, hi := s.EqualRange(k)
+	s.items = slices.Delete(s.items, lo, hi)
+	return hi - lo
+}
+
+// RemoveAt removes and returns the element at index i. It panics if i is out of range.
+func (s *SortedSlice[T, K]) RemoveAt(i int) T {
+	x := s.items[i]
+	s.items = slices.Delete(s.items, i, i+1)
+	return x
+}
+
+// Search returns the index of the first element with key k and true, or the
+// index where it would be inserted and false.
+func (s *SortedSlice[T, K]) Search(k K) (int, bool) {
+	return binarySearchBy(s.items, k, s.key, s.compare)
+}
+
+func (s *SortedSlice[T, K]) Contains(k K) bool {
+	_, ok := s.Search(k)
+	return ok
+}
+
+// Get returns a copy of the elements with key k.
+func (s *SortedSlice[T, K]) Get(k K) []T {
+	return s.Range(k, k)
+}
+
+func (s *SortedSlice[T, K]) LowerBound(k K) int {
+	return lowerBound(s.items, k, s.key, s.compare)
+}
+
+func (s *SortedSlice[T, K]) UpperBound(k K) int {
+	return upperBound(s.items, k, s.key, s.compare)
+}
+
+// EqualRange returns the bounds [lo, hi) of the elements with key k.
+func (s *SortedSlice[T, K]) EqualRange(k K) (int, int) {
+	return s.LowerBound(k), s.UpperBound(k)
+}
+
+// Range returns a copy of the elements whose key is between from and to, both included.
+func (s *SortedSlice[T, K]) Range(from, to K) []T {
+	return rangeBy(s.items, from, to, s.key, s.compare)
+}
+
+// Merge returns a new sorted slice with the elements of s and other, in O(n).
+// On equal keys the elements of s come first.
+func (s *SortedSlice[T, K]) Merge(other *SortedSlice[T, K]) *SortedSlice[T, K] {
+	return &SortedSlice[T, K]{
+		items:   MergeSorted(s.compareItems, s.items, other.items),
+		key:     s.key,
+		compare: s.compare,
+	}
+}
+
+// All iterates over the indexes and elements in key order.
+func (s *SortedSlice[T, K]) All() iter.Seq2[int, T] {
+	return slices.All(s.items)
+}
+
+// Values iterates over the elements in key order.
+func (s *SortedSlice[T, K]) Values() iter.Seq[T] {
+	return slices.Values(s.items)
+}
+
+// Slice copies the elements into a new slice.
+func (s *SortedSlice[T, K]) Slice() []T {
+	return slices.Clone(s.items)
+}
+
+func (s *SortedSlice[T, K]) Array() Array[T] {
+	return s.Slice()
+}
@@ -0,0 +1,129 @@
+package array_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestBinarySearch(t *testing.T) {
+	id := func(n int) int { return n }
+	a := []int{1, 2, 2, 2, 5}
+
+	t.Run("test binary search by", func(t *testing.T) {
+		if i, ok := array.BinarySearchBy(a, 2, id); i != 1 || !ok {
+			t.Error("BinarySearchBy failed. Got", i, ok)
+		}
+		if i, ok := array.BinarySearchBy(a, 3, id); i != 4 || ok {
+			t.Error("BinarySearchBy failed. Got", i, ok)
+		}
+	})
+
+	t.Run("test bounds", func(t *testing.T) {
+		if got := array.LowerBound(a, 2, id); got != 1 {
+			t.Error("LowerBound failed. Got", got, "Expected", 1)
+		}
+		if got := array.UpperBound(a, 2, id); got != 4 {
+			t.Error("UpperBound failed. Got", got, "Expected", 4)
+		}
+		if lo, hi := array.EqualRange(a, 3, id); lo != 4 || hi != 4 {
+			t.Error("EqualRange failed. Got", lo, hi)
+		}
+		if lo, hi := array.EqualRange([]int(nil), 3, id); lo != 0 || hi != 0 {
+			t.Error("EqualRange failed on an empty slice. Got", lo, hi)
+		}
+	})
+
+	t.Run("test range by", func(t *testing.T) {
+		if got := array.RangeBy(a, 2, 4, id); !reflect.DeepEqual(got, []int{2, 2, 2}) {
+			t.Error("RangeBy failed. Got", got)
+		}
+		if got := array.RangeBy(a, 4, 1, id); len(got) != 0 {
+			t.Error("RangeBy failed on an inverted range. Got", got)
+		}
+	})
+
+	t.Run("test chain", func(t *testing.T) {
+		people := array.Array[member]{{"Bob", 20}, {"Ann", 30}, {"Cid", 40}}
+		age := func(m member) float64 { return float64(m.Age) }
+		if got := people.RangeBy(25, 40, age); !reflect.DeepEqual(got, array.Array[member]{{"Ann", 30}, {"Cid", 40}}) {
+			t.Error("RangeBy failed. Got", got)
+		}
+		if i, ok := people.BinarySearchBy(40, age); i != 2 || !ok {
+			t.Error("BinarySearchBy failed. Got", i, ok)
+		}
+	})
+}
+
+func TestSortedSlice(t *testing.T) {
+	age := func(m member) int { return m.Age }
+
+	t.Run("test insert and remove keep order", func(t *testing.T) {
+		s := array.NewSortedSlice(age, member{"Cid", 40}, member{"Ann", 30})
+		s.Insert(member{"Bob", 20}, member{"Dan", 30})
+		want := []member{{"Bob", 20}, {"Ann", 30}, {"Dan", 30}, {"Cid", 40}}
+		if got := s.Slice(); !reflect.DeepEqual(got, want) {
+			t.Error("Insert failed. Got", got, "Expected", want)
+		}
+		if got := s.Get(30); !reflect.DeepEqual(got, []member{{"Ann", 30}, {"Dan", 30}}) {
+			t.Error("Get failed. Got", got)
+		}
+		if n := s.Remove(30); n != 2 || s.Contains(30) || s.Len() != 2 {
+			t.Error("Remove failed. Got", n, s.Slice())
+		}
+		if x := s.RemoveAt(0); x.Name != "Bob" || s.At(0).Name != "Cid" {
+			t.Error("RemoveAt failed. Got", x)
+		}
+	})
+
+	t.Run("test search", func(t *testing.T) {
+		s := array.NewSortedSlice(func(n int) int { return n }, 5, 1, 3, 3)
+		if i, ok := s.Search(4); i != 3 || ok {
+			t.Error("Search failed. Got", i, ok)
+		}
+		if lo, hi := s.EqualRange(3); lo != 1 || hi != 3 {
+			t.Error("EqualRange failed. Got", lo, hi)
+		}
+		if s.LowerBound(0) != 0 || s.UpperBound(9) != 4 {
+			t.Error("bounds failed. Got", s.LowerBound(0), s.UpperBound(9))
+		}
+	})
+
+	t.Run("test time range query", func(t *testing.T) {
+		day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+		type order struct {
+			ID int
+			At time.Time
+		}
+		orders := array.NewSortedSliceFunc(func(o order) time.Time { return o.At }, time.Time.Compare,
+			order{1, day(9)}, order{2, day(1)}, order{3, day(5)}, order{4, day(20)},
+		)
+		got := orders.Range(day(1), day(9))
+		if len(got) != 3 || got[0].ID != 2 || got[2].ID != 1 {
+			t.Error("Range failed. Got", got)
+		}
+	})
+
+	t.Run("test merge", func(t *testing.T) {
+		a := array.NewSortedSlice(age, member{"Ann", 20}, member{"Cid", 40})
+		b := array.NewSortedSlice(age, member{"Bob", 20}, member{"Dan", 30})
+		merged := a.Merge(b)
+		want := array.Array[member]{{"Ann", 20}, {"Bob", 20}, {"Dan", 30}, {"Cid", 40}}
+		if got := merged.Array(); !reflect.DeepEqual(got, want) {
+			t.Error("Merge failed. Got", got, "Expected", want)
+		}
+		merged.Insert(member{"Eve", 10})
+		if a.Len() != 2 || b.Len() != 2 {
+			t.Error("Merge shares memory with its inputs")
+		}
+	})
+
+	t.Run("test chain", func(t *testing.T) {
+		s := array.Numbers[int]{3, 1, 2}.SortedSlice(func(n int) float64 { return float64(n) })
+		if got := s.Slice(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+			t.Error("SortedSlice failed. Got", got)
+		}
+	})
+}
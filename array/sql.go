@@ -0,0 +1,267 @@
+package array
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// SQLEncoding is how a slice is stored in a database column.
+type SQLEncoding int
+
+const (
+	// JSONEncoding stores the slice as a JSON array, for json, jsonb or text columns.
+	JSONEncoding SQLEncoding = iota
+	// PostgresEncoding stores the slice as a Postgres array literal such as {1,2,3}.
+	PostgresEncoding
+)
+
+/* SQLArray makes a slice usable as a query argument (driver.Valuer) and as a
+* Scan destination (sql.Scanner) with the given encoding. A nil slice is stored
+* as SQL NULL and an empty slice as an empty array, and both come back as they
+* were stored. Chain types implement the two interfaces themselves with
+* JSONEncoding, so SQLArray is only needed for Postgres arrays.
+* Example:
+*   var tags Array[string]
+*   err := db.QueryRow("SELECT tags FROM posts WHERE id = $1", id).
+*       Scan(Column(&tags, PostgresEncoding))
+*   _, err = db.Exec("UPDATE posts SET tags = $1", Column(&tags, PostgresEncoding))
+ */
+type SQLArray[S ~[]T, T any] struct {
+	Slice    *S
+	Encoding SQLEncoding
+}
+
+// Column wraps a pointer to a slice or to a chain type.
+func Column[S ~[]T, T any](s *S, encoding SQLEncoding) SQLArray[S, T] {
+	return SQLArray[S, T]{Slice: s, Encoding: encoding}
+}
+
+func (c SQLArray[S, T]) Value() (driver.Value, error) {
+	return sqlValue([]T(*c.Slice), c.Encoding)
+}
+
+func (c SQLArray[S, T]) Scan(src any) error {
+	w, err := sqlScan[T](src, c.Encoding)
+	if err != nil {
+		return err
+	}
+	*c.Slice = w
+
+	return nil
+}
+
+func sqlValue[T any](w []T, encoding SQLEncoding) (driver.Value, error) {
+	if w == nil {
+		return nil, nil
+	}
+
+	switch encoding {
+	case JSONEncoding:
+		return json.Marshal(w)
+	case PostgresEncoding:
+		return formatPostgresArray(w)
+	}
+
+	return nil, fmt.Errorf("unknown sql encoding %d", encoding)
+}
+
+func sqlScan[T any](src any, encoding SQLEncoding) ([]T, error) {
+	var data string
+	switch v := src.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		data = string(v)
+	case string:
+		data = v
+	default:
+		return nil, fmt.Errorf("cannot scan %T into an array", src)
+	}
+
+	switch encoding {
+	case JSONEncoding:
+		var w []T
+		if err := json.Unmarshal([]byte(data), &w); err != nil {
+			return nil, err
+		}
+		return w, nil
+	case PostgresEncoding:
+		return parsePostgresArray[T](data)
+	}
+
+	return nil, fmt.Errorf("unknown sql encoding %d", encoding)
+}
+
+// formatPostgresArray writes numbers and booleans as they are and quotes every
+// other element; structs and maps are quoted as their JSON encoding.
+func formatPostgresArray[T any](w []T) (string, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, x := range w {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		raw, err := json.Marshal(x)
+		if err != nil {
+			return "", err
+		}
+
+		text := string(raw)
+		switch {
+		case text == "null":
+			b.WriteString("NULL")
+			continue
+		case text[0] == '"':
+			if err := json.Unmarshal(raw, &text); err != nil {
+				return "", err
+			}
+		case text[0] != '{' && text[0] != '[':
+			b.WriteString(text)
+			continue
+		}
+		b.WriteByte('"')
+		b.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+
+	return b.String(), nil
+}
+
+type postgresElement struct {
+	text string
+	null bool
+}
+
+// parsePostgresArray reads a one-dimensional Postgres array literal. NULL
+// elements decode to the zero value of T.
+func parsePostgresArray[T any](s string) ([]T, error) {
+	elements, err := splitPostgresArray(s)
+	if err != nil {
+		return nil, err
+	}
+
+	w := make([]T, len(elements))
+	for i, e := range elements {
+		if e.null {
+			continue
+		}
+		if err := parsePostgresElement(e.text, &w[i]); err != nil {
+			return nil, fmt.Errorf("array element %d: %w", i, err)
+		}
+	}
+
+	return w, nil
+}
+
+func splitPostgresArray(s string) ([]postgresElement, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("invalid postgres array %q", s)
+	}
+	body := s[1 : len(s)-1]
+	if strings.TrimSpace(body) == "" {
+		return []postgresElement{}, nil
+	}
+
+	var elements []postgresElement
+	i := 0
+	for {
+		for i < len(body) && body[i] == ' ' {
+			i++
+		}
+
+		var e postgresElement
+		if i < len(body) && body[i] == '"' {
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= len(body) {
+					return nil, errors.New("unterminated quoted element in postgres array")
+				}
+				if body[i] == '\\' {
+					i++
+					if i >= len(body) {
+						return nil, errors.New("unterminated quoted element in postgres array")
+					}
+				} else if body[i] == '"' {
+					i++
+					break
+				}
+				b.WriteByte(body[i])
+			}
+			e.text = b.String()
+			for i < len(body) && body[i] == ' ' {
+				i++
+			}
+		} else {
+			j := i
+			for j < len(body) && body[j] != ',' {
+				if body[j] == '{' || body[j] == '"' {
+					return nil, errors.New("multidimensional postgres arrays are not supported")
+				}
+				j++
+			}
+			e.text = strings.TrimSpace(body[i:j])
+			e.null = strings.EqualFold(e.text, "NULL")
+			i = j
+			if e.text == "" {
+				return nil, errors.New("empty element in postgres array")
+			}
+		}
+		elements = append(elements, e)
+
+		if i == len(body) {
+			return elements, nil
+		}
+		if body[i] != ',' {
+			return nil, fmt.Errorf("unexpected %q in postgres array", body[i])
+		}
+		i++
+	}
+}
+
+var postgresTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
+func parsePostgresElement[T any](text string, dst *T) error {
+	switch p := any(dst).(type) {
+	case *string:
+		*p = text
+		return nil
+	case *bool:
+		switch strings.ToLower(text) {
+		case "t", "true":
+			*p = true
+		case "f", "false":
+			*p = false
+		default:
+			return fmt.Errorf("invalid boolean %q", text)
+		}
+		return nil
+	case *time.Time:
+		for _, layout := range postgresTimeLayouts {
+			if t, err := time.Parse(layout, text); err == nil {
+				*p = t
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid timestamp %q", text)
+	}
+
+	if v := reflect.ValueOf(dst).Elem(); v.Kind() == reflect.String {
+		v.SetString(text)
+		return nil
+	}
+
+	return json.Unmarshal([]byte(text), dst)
+}
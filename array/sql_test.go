@@ -0,0 +1,231 @@
+package array_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+// fakeDriver stores every value inserted with "INSERT" and returns it on
+// "SELECT" by its position, so values go through the real database/sql conversions.
+type fakeDriver struct {
+	mu   sync.Mutex
+	rows []driver.Value
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+type fakeStmt struct {
+	c     *fakeConn
+	query string
+}
+
+type fakeRows struct {
+	values []driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d}, nil }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) { return &fakeStmt{c, query}, nil }
+func (c *fakeConn) Close() error                              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return 1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.c.d.mu.Lock()
+	defer s.c.d.mu.Unlock()
+	s.c.d.rows = append(s.c.d.rows, args[0])
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.c.d.mu.Lock()
+	defer s.c.d.mu.Unlock()
+	return &fakeRows{values: []driver.Value{s.c.d.rows[args[0].(int64)]}}, nil
+}
+
+func (r *fakeRows) Columns() []string { return []string{"value"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
+
+var registerFakeDriver sync.Once
+
+func openFakeDB(t *testing.T) *sql.DB {
+	t.Helper()
+	registerFakeDriver.Do(func() { sql.Register("arrayfake", &fakeDriver{}) })
+	db, err := sql.Open("arrayfake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// roundTrip inserts value and scans it back into dest, returning what was stored.
+func roundTrip(t *testing.T, db *sql.DB, value any, dest any) driver.Value {
+	t.Helper()
+	if _, err := db.Exec("INSERT", value); err != nil {
+		t.Fatal("Exec failed:", err)
+	}
+
+	d := db.Driver().(*fakeDriver)
+	d.mu.Lock()
+	n := int64(len(d.rows) - 1)
+	stored := d.rows[n]
+	d.mu.Unlock()
+
+	if err := db.QueryRow("SELECT", n).Scan(dest); err != nil {
+		t.Fatal("Scan failed:", err)
+	}
+
+	return stored
+}
+
+func TestSQLJSON(t *testing.T) {
+	db := openFakeDB(t)
+
+	t.Run("test round trip", func(t *testing.T) {
+		tags := array.Array[string]{"go", "sql"}
+		var got array.Array[string]
+		stored := roundTrip(t, db, tags, &got)
+		if string(stored.([]byte)) != `["go","sql"]` {
+			t.Error("Value failed. Got", stored)
+		}
+		if !reflect.DeepEqual(got, tags) {
+			t.Error("Scan failed. Got", got, "Expected", tags)
+		}
+	})
+
+	t.Run("test nil and empty stay distinct", func(t *testing.T) {
+		fromNil := array.Array[int]{1}
+		if stored := roundTrip(t, db, array.Array[int](nil), &fromNil); stored != nil || fromNil != nil {
+			t.Error("nil failed. Got", stored, fromNil)
+		}
+
+		var fromEmpty array.Numbers[int]
+		stored := roundTrip(t, db, array.Numbers[int]{}, &fromEmpty)
+		if string(stored.([]byte)) != "[]" || fromEmpty == nil || len(fromEmpty) != 0 {
+			t.Error("empty failed. Got", stored, fromEmpty)
+		}
+	})
+
+	t.Run("test struct elements", func(t *testing.T) {
+		type line struct {
+			SKU string
+			Qty int
+		}
+		lines := array.Array[line]{{"a", 1}, {"b", 2}}
+		var got array.Array[line]
+		roundTrip(t, db, lines, &got)
+		if !reflect.DeepEqual(got, lines) {
+			t.Error("Scan failed. Got", got, "Expected", lines)
+		}
+	})
+
+	t.Run("test invalid source", func(t *testing.T) {
+		var got array.ComparableArray[int]
+		if err := got.Scan(42); err == nil {
+			t.Error("Scan should fail for", 42)
+		}
+		if err := got.Scan(`{1,2}`); err == nil {
+			t.Error("Scan should fail for a postgres literal with JSONEncoding")
+		}
+	})
+}
+
+func TestSQLPostgres(t *testing.T) {
+	db := openFakeDB(t)
+
+	t.Run("test strings", func(t *testing.T) {
+		tags := array.Array[string]{"go", `say "hi"`, `back\slash`, "a,b", "NULL", ""}
+		var got array.Array[string]
+		stored := roundTrip(t, db, array.Column(&tags, array.PostgresEncoding), array.Column(&got, array.PostgresEncoding))
+		exp := `{"go","say \"hi\"","back\\slash","a,b","NULL",""}`
+		if stored != exp {
+			t.Error("Value failed. Got", stored, "Expected", exp)
+		}
+		if !reflect.DeepEqual(got, tags) {
+			t.Error("Scan failed. Got", got, "Expected", tags)
+		}
+	})
+
+	t.Run("test named string type", func(t *testing.T) {
+		type tag string
+		tags := array.Array[tag]{"null", `"q"`, "123", "plain"}
+		var got array.Array[tag]
+		roundTrip(t, db, array.Column(&tags, array.PostgresEncoding), array.Column(&got, array.PostgresEncoding))
+		if !reflect.DeepEqual(got, tags) {
+			t.Error("Scan failed. Got", got, "Expected", tags)
+		}
+	})
+
+	t.Run("test numbers and booleans", func(t *testing.T) {
+		ids := []int64{3, -1, 20}
+		var got array.Numbers[int64]
+		stored := roundTrip(t, db, array.Column(&ids, array.PostgresEncoding), array.Column(&got, array.PostgresEncoding))
+		if stored != "{3,-1,20}" || !reflect.DeepEqual([]int64(got), ids) {
+			t.Error("int64 failed. Got", stored, got)
+		}
+
+		var flags []bool
+		if err := array.Column(&flags, array.PostgresEncoding).Scan("{t,f,true}"); err != nil || !reflect.DeepEqual(flags, []bool{true, false, true}) {
+			t.Error("bool failed. Got", flags, err)
+		}
+	})
+
+	t.Run("test timestamps", func(t *testing.T) {
+		var got []time.Time
+		err := array.Column(&got, array.PostgresEncoding).Scan(`{"2024-01-02 03:04:05+00","2024-01-03 00:00:00.5+02"}`)
+		exp := []time.Time{
+			time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			time.Date(2024, 1, 2, 22, 0, 0, 500000000, time.UTC),
+		}
+		if err != nil || len(got) != 2 || !got[0].Equal(exp[0]) || !got[1].Equal(exp[1]) {
+			t.Error("timestamps failed. Got", got, err, "Expected", exp)
+		}
+	})
+
+	t.Run("test nil, empty and NULL elements", func(t *testing.T) {
+		fromNil := []string{"x"}
+		if stored := roundTrip(t, db, array.Column(new([]string), array.PostgresEncoding), array.Column(&fromNil, array.PostgresEncoding)); stored != nil || fromNil != nil {
+			t.Error("nil failed. Got", stored, fromNil)
+		}
+
+		empty := []string{}
+		var fromEmpty []string
+		if stored := roundTrip(t, db, array.Column(&empty, array.PostgresEncoding), array.Column(&fromEmpty, array.PostgresEncoding)); stored != "{}" || fromEmpty == nil {
+			t.Error("empty failed. Got", stored, fromEmpty)
+		}
+
+		var withNull []*int
+		if err := array.Column(&withNull, array.PostgresEncoding).Scan("{1, NULL ,3}"); err != nil || len(withNull) != 3 || withNull[1] != nil || *withNull[2] != 3 {
+			t.Error("NULL element failed. Got", withNull, err)
+		}
+	})
+
+	t.Run("test invalid literals", func(t *testing.T) {
+		var got []int
+		for _, s := range []string{"1,2", `{"1`, "{1,,2}", "{{1,2},{3,4}}", "{a}"} {
+			if err := array.Column(&got, array.PostgresEncoding).Scan(s); err == nil {
+				t.Error("Scan should fail for", s)
+			}
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package array
+
+import (
+	"time"
+)
+
+// CalendarUnit selects a calendar aware bucket width for TimeBucket.
+type CalendarUnit int
+
+const (
+	// NoUnit means the bucket uses a fixed Duration instead of a calendar unit.
+	NoUnit CalendarUnit = iota
+	DayUnit
+	ISOWeekUnit
+	MonthUnit
+	QuarterUnit
+)
+
+/* TimeBucket describes how timestamps are truncated into buckets.
+* Fixed durations are aligned to the zero time, like time.Truncate, so buckets
+* that divide a day start at UTC midnight. Calendar units are computed on the
+* wall clock of Location (UTC when nil), so a day bucket always starts at local
+* midnight even across DST changes. ISO weeks start on Monday.
+*
+* Example:
+*   daily := array.DayBucket(time.UTC).FillGaps()
+*   hourly := array.FixedBucket(time.Hour)
+ */
+type TimeBucket struct {
+	Duration time.Duration
+	Unit     CalendarUnit
+	Location *time.Location
+	Fill     bool
+}
+
+func FixedBucket(d time.Duration) TimeBucket {
+	if d <= 0 {
+		panic("bucket duration must be positive")
+	}
+	return TimeBucket{Duration: d}
+}
+
+func DayBucket(loc *time.Location) TimeBucket {
+	return TimeBucket{Unit: DayUnit, Location: loc}
+}
+
+func WeekBucket(loc *time.Location) TimeBucket {
+	return TimeBucket{Unit: ISOWeekUnit, Location: loc}
+}
+
+func MonthBucket(loc *time.Location) TimeBucket {
+	return TimeBucket{Unit: MonthUnit, Location: loc}
+}
+
+func QuarterBucket(loc *time.Location) TimeBucket {
+	return TimeBucket{Unit: QuarterUnit, Location: loc}
+}
+
+// FillGaps returns a copy of the bucket that adds empty buckets between the
+// first and the last bucket found in the data.
+func (b TimeBucket) FillGaps() TimeBucket {
+	b.Fill = true
+	return b
+}
+
+func (b TimeBucket) location() *time.Location {
+	if b.Location == nil {
+		return time.UTC
+	}
+	return b.Location
+}
+
+// Truncate returns the start of the bucket that contains t.
+func (b TimeBucket) Truncate(t time.Time) time.Time {
+	loc := b.location()
+	t = t.In(loc)
+	y, m, d := t.Date()
+	switch b.Unit {
+	case DayUnit:
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+	case ISOWeekUnit:
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, loc)
+	case MonthUnit:
+		return time.Date(y, m, 1, 0, 0, 0, 0, loc)
+	case QuarterUnit:
+		return time.Date(y, m-(m-1)%3, 1, 0, 0, 0, 0, loc)
+	}
+	if b.Duration <= 0 {
+		panic("bucket needs a positive duration or a calendar unit")
+	}
+
+	return t.Truncate(b.Duration)
+}
+
+// Next returns the start of the bucket that follows the bucket starting at start.
+func (b TimeBucket) Next(start time.Time) time.Time {
+	loc := b.location()
+	start = start.In(loc)
+	y, m, d := start.Date()
+	switch b.Unit {
+	case DayUnit:
+		return time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+	case ISOWeekUnit:
+		return time.Date(y, m, d+7, 0, 0, 0, 0, loc)
+	case MonthUnit:
+		return time.Date(y, m+1, 1, 0, 0, 0, 0, loc)
+	case QuarterUnit:
+		return time.Date(y, m+3, 1, 0, 0, 0, 0, loc)
+	}
+
+	return start.Add(b.Duration)
+}
+
+/* Range returns the start of every bucket between from and to, both included.
+* Example:
+*   from := time.Date(2024, 1, 30, 0, 0, 0, 0, time.UTC)
+*   to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+*   days := array.DayBucket(time.UTC).Range(from, to)
+*   fmt.Println(len(days)) // 3
+ */
+func (b TimeBucket) Range(from, to time.Time) []time.Time {
+	var keys []time.Time
+	last := b.Truncate(to)
+	for k := b.Truncate(from); !k.After(last); k = b.Next(k) {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+func fillTimeBuckets[A any](m map[time.Time]A, b TimeBucket, empty func() A) map[time.Time]A {
+	if !b.Fill || len(m) == 0 {
+		return m
+	}
+
+	var first, last time.Time
+	seen := false
+	for k := range m {
+		if !seen || k.Before(first) {
+			first = k
+		}
+		if !seen || k.After(last) {
+			last = k
+		}
+		seen = true
+	}
+	for _, k := range b.Range(first, last) {
+		if _, ok := m[k]; !ok {
+			m[k] = empty()
+		}
+	}
+
+	return m
+}
+
+/* GroupByTime groups rows by the bucket that contains their timestamp.
+* Keys are the bucket start in the bucket location.
+* Example:
+*   byDay := array.GroupByTime(orders,
+*       func(o Order) time.Time { return o.CreatedAt },
+*       array.DayBucket(time.UTC).FillGaps(),
+*   )
+ */
+func GroupByTime[T any](w []T, ts func(T) time.Time, bucket TimeBucket) map[time.Time][]T {
+	m := GroupBy(w, func(x T) time.Time { return bucket.Truncate(ts(x)) })
+	return fillTimeBuckets(m, bucket, func() []T { return []T{} })
+}
+
+func GroupSumByTime[T any, V Number](w []T, ts func(T) time.Time, value func(T) V, bucket TimeBucket) map[time.Time]V {
+	m := GroupSumBy(w, func(x T) time.Time { return bucket.Truncate(ts(x)) }, value)
+	return fillTimeBuckets(m, bucket, func() V { return 0 })
+}
+
+func GroupCountByTime[T any](w []T, ts func(T) time.Time, bucket TimeBucket) map[time.Time]int {
+	m := GroupCountBy(w, func(x T) time.Time { return bucket.Truncate(ts(x)) })
+	return fillTimeBuckets(m, bucket, func() int { return 0 })
+}
+
+func GroupStatsByTime[T any, V Number](w []T, ts func(T) time.Time, value func(T) V, bucket TimeBucket) map[time.Time]GroupStats[V] {
+	m := GroupStatsBy(w, func(x T) time.Time { return bucket.Truncate(ts(x)) }, value)
+	return fillTimeBuckets(m, bucket, func() GroupStats[V] { return GroupStats[V]{} })
+}
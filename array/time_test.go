@@ -0,0 +1,104 @@
+package array_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+type event struct {
+	At    time.Time
+	Value float64
+}
+
+func day(y int, m time.Month, d, h int) time.Time {
+	return time.Date(y, m, d, h, 0, 0, 0, time.UTC)
+}
+
+func TestTimeBucketTruncate(t *testing.T) {
+	ts := time.Date(2024, 5, 16, 13, 45, 0, 0, time.UTC) // Thursday
+
+	cases := []struct {
+		name     string
+		bucket   array.TimeBucket
+		expected time.Time
+	}{
+		{"fixed", array.FixedBucket(6 * time.Hour), day(2024, 5, 16, 12)},
+		{"day", array.DayBucket(nil), day(2024, 5, 16, 0)},
+		{"iso week", array.WeekBucket(time.UTC), day(2024, 5, 13, 0)},
+		{"month", array.MonthBucket(time.UTC), day(2024, 5, 1, 0)},
+		{"quarter", array.QuarterBucket(time.UTC), day(2024, 4, 1, 0)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.bucket.Truncate(ts); !got.Equal(c.expected) {
+				t.Error("Truncate failed. Got", got, "Expected", c.expected)
+			}
+		})
+	}
+}
+
+func TestTimeBucketLocation(t *testing.T) {
+	loc := time.FixedZone("UTC-3", -3*60*60)
+	ts := time.Date(2024, 5, 16, 1, 0, 0, 0, time.UTC) // still May 15 in UTC-3
+
+	got := array.DayBucket(loc).Truncate(ts)
+	expected := time.Date(2024, 5, 15, 0, 0, 0, 0, loc)
+	if !got.Equal(expected) || got.Location() != loc {
+		t.Error("Truncate failed. Got", got, "Expected", expected)
+	}
+}
+
+func TestTimeBucketRange(t *testing.T) {
+	months := array.QuarterBucket(time.UTC).Range(day(2023, 11, 20, 0), day(2024, 5, 1, 0))
+	if len(months) != 3 || !months[0].Equal(day(2023, 10, 1, 0)) || !months[2].Equal(day(2024, 4, 1, 0)) {
+		t.Error("Range failed. Got", months)
+	}
+}
+
+func TestGroupByTime(t *testing.T) {
+	events := []event{
+		{day(2024, 1, 1, 9), 10},
+		{day(2024, 1, 1, 18), 20},
+		{day(2024, 1, 4, 7), 5},
+	}
+	at := func(e event) time.Time { return e.At }
+	value := func(e event) float64 { return e.Value }
+
+	t.Run("without fill", func(t *testing.T) {
+		grouped := array.GroupByTime(events, at, array.DayBucket(time.UTC))
+		if len(grouped) != 2 || len(grouped[day(2024, 1, 1, 0)]) != 2 {
+			t.Error("GroupByTime failed. Got", grouped)
+		}
+	})
+
+	t.Run("with fill", func(t *testing.T) {
+		grouped := array.GroupByTime(events, at, array.DayBucket(time.UTC).FillGaps())
+		if len(grouped) != 4 {
+			t.Error("GroupByTime failed. Got", len(grouped), "Expected", 4)
+		}
+		empty, ok := grouped[day(2024, 1, 2, 0)]
+		if !ok || empty == nil || len(empty) != 0 {
+			t.Error("GroupByTime failed. Got", empty, "Expected empty bucket")
+		}
+	})
+
+	t.Run("aggregations", func(t *testing.T) {
+		bucket := array.DayBucket(time.UTC).FillGaps()
+		sum := array.GroupSumByTime(events, at, value, bucket)
+		if len(sum) != 4 || sum[day(2024, 1, 1, 0)] != 30 || sum[day(2024, 1, 3, 0)] != 0 {
+			t.Error("GroupSumByTime failed. Got", sum)
+		}
+		count := array.GroupCountByTime(events, at, bucket)
+		if count[day(2024, 1, 1, 0)] != 2 || count[day(2024, 1, 2, 0)] != 0 {
+			t.Error("GroupCountByTime failed. Got", count)
+		}
+		stats := array.GroupStatsByTime(events, at, value, bucket)
+		expected := array.GroupStats[float64]{Count: 2, Sum: 30, Min: 10, Max: 20, Avg: 15}
+		if stats[day(2024, 1, 1, 0)] != expected || stats[day(2024, 1, 2, 0)].Count != 0 {
+			t.Error("GroupStatsByTime failed. Got", stats)
+		}
+	})
+}
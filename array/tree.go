@@ -0,0 +1,310 @@
+package array
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Node is an element of a tree built by BuildTree.
+type Node[T any] struct {
+	Value    T
+	Parent   *Node[T]
+	Children []*Node[T]
+}
+
+// Forest is the list of root nodes of one or more trees.
+type Forest[T any] []*Node[T]
+
+// TreeRow is an element of a flattened tree with its depth; roots have depth 0.
+type TreeRow[T any] struct {
+	Row   T
+	Depth int
+}
+
+// TreeReport lists the items BuildTree could not place under a root.
+type TreeReport[T any] struct {
+	// Orphans are the nodes whose parent ID matches no item. They keep their
+	// subtrees but are not in the forest.
+	Orphans Forest[T]
+	// Cycles are the items whose parent links loop, each cycle in parent order.
+	Cycles [][]T
+	// Detached are the items below a cycle, which no root can reach.
+	Detached []T
+}
+
+// Err returns nil when every item was placed, and a *TreeError holding the
+// report otherwise.
+func (r TreeReport[T]) Err() error {
+	if len(r.Orphans) == 0 && len(r.Cycles) == 0 && len(r.Detached) == 0 {
+		return nil
+	}
+	return &TreeError[T]{Report: r}
+}
+
+/* TreeError is the error of a TreeReport with problems. errors.As recovers it,
+* and with it the items that were not placed.
+* Example:
+*   _, err := pipe.BuildTree(id, parentID)(categories)
+*   var treeErr *array.TreeError[Category]
+*   if errors.As(err, &treeErr) {
+*       fmt.Println(treeErr.Report.Orphans)
+*   }
+ */
+type TreeError[T any] struct {
+	Report TreeReport[T]
+}
+
+func (e *TreeError[T]) Error() string {
+	r := e.Report
+	return fmt.Sprintf("tree has %d orphans, %d cycles and %d detached items", len(r.Orphans), len(r.Cycles), len(r.Detached))
+}
+
+/* BuildTree links flat items into trees by their IDs. Items whose parent ID is
+* the zero value are roots. Children keep the order of the input. Items whose
+* parent is missing, or whose parent links form a cycle, are not in the forest
+* and are listed in the report instead. IDs are expected to be unique; with
+* duplicates, children attach to the first item with the ID.
+* Example:
+*   roots, report := BuildTree(categories,
+*       func(c Category) int { return c.ID },
+*       func(c Category) int { return c.ParentID },
+*   )
+*   if err := report.Err(); err != nil {
+*       log.Println(err)
+*   }
+*   for _, row := range roots.Flatten() {
+*       fmt.Println(strings.Repeat("  ", row.Depth) + row.Row.Name)
+*   }
+ */
+func BuildTree[T any, K comparable](items []T, id func(T) K, parentID func(T) K) (Forest[T], TreeReport[T]) {
+	nodes := make([]*Node[T], len(items))
+	byID := make(map[K]*Node[T], len(items))
+	for i, x := range items {
+		nodes[i] = &Node[T]{Value: x}
+		if _, ok := byID[id(x)]; !ok {
+			byID[id(x)] = nodes[i]
+		}
+	}
+
+	var zero K
+	var roots Forest[T]
+	var report TreeReport[T]
+	for i, x := range items {
+		pid := parentID(x)
+		if pid == zero {
+			roots = append(roots, nodes[i])
+			continue
+		}
+		parent, ok := byID[pid]
+		if !ok {
+			report.Orphans = append(report.Orphans, nodes[i])
+			continue
+		}
+		nodes[i].Parent = parent
+		parent.Children = append(parent.Children, nodes[i])
+	}
+
+	reached := make(map[*Node[T]]bool, len(nodes))
+	for _, forest := range []Forest[T]{roots, report.Orphans} {
+		for n := range forest.DFS() {
+			reached[n] = true
+		}
+	}
+	if len(reached) == len(nodes) {
+		return roots, report
+	}
+
+	// The nodes left over all follow their parent links into a cycle.
+	const (
+		walking = 1
+		done    = 2
+	)
+	state := make(map[*Node[T]]int)
+	for _, n := range nodes {
+		if reached[n] || state[n] == done {
+			continue
+		}
+		var path []*Node[T]
+		p := n
+		for state[p] == 0 {
+			state[p] = walking
+			path = append(path, p)
+			p = p.Parent
+		}
+		detached := path
+		if state[p] == walking {
+			start := slices.Index(path, p)
+			cycle := make([]T, 0, len(path)-start)
+			for _, c := range path[start:] {
+				cycle = append(cycle, c.Value)
+			}
+			report.Cycles = append(report.Cycles, cycle)
+			detached = path[:start]
+		}
+		for _, d := range detached {
+			report.Detached = append(report.Detached, d.Value)
+		}
+		for _, c := range path {
+			state[c] = done
+		}
+	}
+
+	return roots, report
+}
+
+func (n *Node[T]) IsRoot() bool {
+	return n.Parent == nil
+}
+
+func (n *Node[T]) IsLeaf() bool {
+	return len(n.Children) == 0
+}
+
+// Depth returns the number of ancestors of n.
+func (n *Node[T]) Depth() int {
+	depth := 0
+	for p := n.Parent; p != nil; p = p.Parent {
+		depth++
+	}
+	return depth
+}
+
+// PathToRoot returns the values from n up to its root, both included.
+func (n *Node[T]) PathToRoot() []T {
+	var path []T
+	for p := n; p != nil; p = p.Parent {
+		path = append(path, p.Value)
+	}
+	return path
+}
+
+// Size returns the number of nodes in the subtree of n, n included.
+func (n *Node[T]) Size() int {
+	size := 0
+	for range n.DFS() {
+		size++
+	}
+	return size
+}
+
+// DFS iterates over the subtree of n depth-first, each node before its children.
+func (n *Node[T]) DFS() iter.Seq[*Node[T]] {
+	return Forest[T]{n}.DFS()
+}
+
+// BFS iterates over the subtree of n level by level.
+func (n *Node[T]) BFS() iter.Seq[*Node[T]] {
+	return Forest[T]{n}.BFS()
+}
+
+// Flatten lists the subtree of n depth-first with the depth of each node.
+func (n *Node[T]) Flatten() []TreeRow[T] {
+	rows := Forest[T]{n}.Flatten()
+	offset := n.Depth()
+	for i := range rows {
+		rows[i].Depth += offset
+	}
+	return rows
+}
+
+// DFS iterates over the trees depth-first, each node before its children.
+func (f Forest[T]) DFS() iter.Seq[*Node[T]] {
+	return func(yield func(*Node[T]) bool) {
+		stack := make([]*Node[T], 0, len(f))
+		for i := len(f) - 1; i >= 0; i-- {
+			stack = append(stack, f[i])
+		}
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n) {
+				return
+			}
+			for i := len(n.Children) - 1; i >= 0; i-- {
+				stack = append(stack, n.Children[i])
+			}
+		}
+	}
+}
+
+// BFS iterates over the trees level by level, roots first.
+func (f Forest[T]) BFS() iter.Seq[*Node[T]] {
+	return func(yield func(*Node[T]) bool) {
+		queue := slices.Clone(f)
+		for i := 0; i < len(queue); i++ {
+			if !yield(queue[i]) {
+				return
+			}
+			queue = append(queue, queue[i].Children...)
+		}
+	}
+}
+
+// Flatten lists the trees depth-first with the depth of each node, the order
+// of an indented outline.
+func (f Forest[T]) Flatten() []TreeRow[T] {
+	var rows []TreeRow[T]
+	var walk func(n *Node[T], depth int)
+	walk = func(n *Node[T], depth int) {
+		rows = append(rows, TreeRow[T]{Row: n.Value, Depth: depth})
+		for _, c := range n.Children {
+			walk(c, depth+1)
+		}
+	}
+	for _, n := range f {
+		walk(n, 0)
+	}
+
+	return rows
+}
+
+// Values returns the values of the trees depth-first.
+func (f Forest[T]) Values() []T {
+	var values []T
+	for n := range f.DFS() {
+		values = append(values, n.Value)
+	}
+	return values
+}
+
+/* FoldTree aggregates the subtree of n bottom-up: f receives the value of each
+* node and the results of its children.
+* Example:
+*   total := FoldTree(root, func(c Category, children []int) int {
+*       return Fold(children, c.Products, func(sum, n int) int { return sum + n })
+*   })
+ */
+func FoldTree[T, A any](n *Node[T], f func(x T, children []A) A) A {
+	results := make([]A, len(n.Children))
+	for i, c := range n.Children {
+		results[i] = FoldTree(c, f)
+	}
+	return f(n.Value, results)
+}
+
+/* SubtreeStats returns, for every node of the trees, the stats of value over
+* its subtree, keyed by id.
+* Example:
+*   stats := SubtreeStats(orgChart,
+*       func(e Employee) string { return e.ID },
+*       func(e Employee) float64 { return e.Salary },
+*   )
+*   fmt.Println(stats["cto"].Sum) // payroll of the CTO's organization
+ */
+func SubtreeStats[T any, K comparable, V Number](roots Forest[T], id func(T) K, value func(T) V) map[K]GroupStats[V] {
+	stats := make(map[K]GroupStats[V])
+	for _, root := range roots {
+		FoldTree(root, func(x T, children []GroupStats[V]) GroupStats[V] {
+			var s GroupStats[V]
+			s.Add(value(x))
+			for _, c := range children {
+				s.Merge(c)
+			}
+			stats[id(x)] = s
+			return s
+		})
+	}
+
+	return stats
+}
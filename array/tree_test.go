@@ -0,0 +1,141 @@
+package array_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+type category struct {
+	ID, ParentID int
+	Name         string
+	Products     int
+}
+
+func TestBuildTree(t *testing.T) {
+	id := func(c category) int { return c.ID }
+	parentID := func(c category) int { return c.ParentID }
+	names := func(values []category) []string {
+		return array.Map(values, func(c category) string { return c.Name })
+	}
+
+	categories := []category{
+		{3, 1, "phones", 4},
+		{1, 0, "electronics", 1},
+		{2, 0, "books", 2},
+		{4, 1, "laptops", 3},
+		{5, 3, "cases", 5},
+	}
+
+	t.Run("test roots and children keep input order", func(t *testing.T) {
+		roots, report := array.BuildTree(categories, id, parentID)
+		if err := report.Err(); err != nil {
+			t.Error("BuildTree reported", err)
+		}
+		if got := names(roots.Values()); !reflect.DeepEqual(got, []string{"electronics", "phones", "cases", "laptops", "books"}) {
+			t.Error("BuildTree failed. Got", got)
+		}
+		if !roots[0].IsRoot() || !roots[1].IsRoot() || !roots[1].IsLeaf() {
+			t.Error("IsRoot or IsLeaf failed")
+		}
+	})
+
+	t.Run("test orphans and cycles", func(t *testing.T) {
+		broken := append([]category{
+			{6, 9, "orphan", 0},
+			{7, 6, "under orphan", 0},
+			{8, 10, "loop a", 0},
+			{10, 8, "loop b", 0},
+			{11, 10, "below loop", 0},
+			{12, 12, "self", 0},
+		}, categories...)
+		roots, report := array.BuildTree(broken, id, parentID)
+		if len(roots) != 2 {
+			t.Error("BuildTree failed. Got", len(roots), "roots")
+		}
+		if len(report.Orphans) != 1 || report.Orphans[0].Size() != 2 {
+			t.Error("Orphans failed. Got", report.Orphans)
+		}
+		if got := array.Map(report.Cycles, names); !reflect.DeepEqual(got, [][]string{{"loop a", "loop b"}, {"self"}}) {
+			t.Error("Cycles failed. Got", got)
+		}
+		if got := names(report.Detached); !reflect.DeepEqual(got, []string{"below loop"}) {
+			t.Error("Detached failed. Got", got)
+		}
+		if report.Err() == nil {
+			t.Error("Err failed to report problems")
+		}
+	})
+
+	t.Run("test walks", func(t *testing.T) {
+		roots, _ := array.BuildTree(categories, id, parentID)
+		var bfs []category
+		for n := range roots.BFS() {
+			bfs = append(bfs, n.Value)
+		}
+		if got := names(bfs); !reflect.DeepEqual(got, []string{"electronics", "books", "phones", "laptops", "cases"}) {
+			t.Error("BFS failed. Got", got)
+		}
+
+		var cases *array.Node[category]
+		for n := range roots.DFS() {
+			if n.Value.Name == "cases" {
+				cases = n
+				break
+			}
+		}
+		if cases.Depth() != 2 {
+			t.Error("Depth failed. Got", cases.Depth())
+		}
+		if got := names(cases.PathToRoot()); !reflect.DeepEqual(got, []string{"cases", "phones", "electronics"}) {
+			t.Error("PathToRoot failed. Got", got)
+		}
+		if got := cases.Parent.Flatten(); len(got) != 2 || got[1].Depth != 2 {
+			t.Error("Node.Flatten failed. Got", got)
+		}
+	})
+
+	t.Run("test flatten", func(t *testing.T) {
+		roots, _ := array.BuildTree(categories, id, parentID)
+		depths := array.Map(roots.Flatten(), func(r array.TreeRow[category]) int { return r.Depth })
+		if !reflect.DeepEqual(depths, []int{0, 1, 2, 1, 0}) {
+			t.Error("Flatten failed. Got", depths)
+		}
+	})
+
+	t.Run("test aggregation", func(t *testing.T) {
+		roots, _ := array.BuildTree(categories, id, parentID)
+		total := array.FoldTree(roots[0], func(c category, children []int) int {
+			return array.Fold(children, c.Products, func(sum, n int) int { return sum + n })
+		})
+		if total != 13 {
+			t.Error("FoldTree failed. Got", total, "Expected", 13)
+		}
+
+		stats := array.SubtreeStats(roots, id, func(c category) int { return c.Products })
+		if s := stats[1]; s.Count != 4 || s.Sum != 13 || s.Max != 5 {
+			t.Error("SubtreeStats failed. Got", s)
+		}
+		if s := stats[2]; s.Count != 1 || s.Sum != 2 {
+			t.Error("SubtreeStats failed on a leaf. Got", s)
+		}
+
+		ordered := array.SubtreeStatsOrdered(roots, id, func(c category) int { return c.Products })
+		if got, exp := slices.Collect(ordered.Keys()), array.Map(roots.Values(), id); !reflect.DeepEqual(got, exp) {
+			t.Error("SubtreeStatsOrdered failed. Got", got, "Expected", exp)
+		}
+		if s, _ := ordered.Get(1); s != stats[1] {
+			t.Error("SubtreeStatsOrdered failed. Got", s, "Expected", stats[1])
+		}
+	})
+
+	t.Run("test chain", func(t *testing.T) {
+		rows := array.Array[[2]string]{{"a", ""}, {"b", "a"}}
+		roots, report := rows.BuildTree(func(r [2]string) string { return r[0] }, func(r [2]string) string { return r[1] })
+		if report.Err() != nil || len(roots) != 1 || roots[0].Size() != 2 {
+			t.Error("BuildTree chain failed. Got", roots, report)
+		}
+	})
+}
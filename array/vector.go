@@ -0,0 +1,242 @@
+package array
+
+import "iter"
+
+/* Vector is an immutable sequence. Push, Pop, Shift, Unshift and Set return a
+* new vector and leave the receiver unchanged; the two share every node that
+* did not change, so each operation costs O(log n) time and memory instead of
+* a full copy. Vectors are safe to share between goroutines without locking.
+* The zero value is an empty vector.
+* Example:
+*   base := NewVector(1, 2, 3)
+*   a := base.Push(4)          // [1 2 3 4]
+*   b := base.Unshift(0)       // [0 1 2 3]
+*   _, c := base.Pop()         // [1 2]
+*   fmt.Println(base.Slice())  // [1 2 3]
+ */
+type Vector[T any] struct {
+	root *vectorNode[T]
+}
+
+// vectorNode is a node of a persistent AVL tree ordered by position.
+// Nodes are never modified once built.
+type vectorNode[T any] struct {
+	left, right *vectorNode[T]
+	value       T
+	size        int
+	height      int
+}
+
+func NewVector[T any](items ...T) Vector[T] {
+	return Vector[T]{root: buildVectorNode(items)}
+}
+
+func buildVectorNode[T any](items []T) *vectorNode[T] {
+	if len(items) == 0 {
+		return nil
+	}
+	mid := len(items) / 2
+	return newVectorNode(buildVectorNode(items[:mid]), items[mid], buildVectorNode(items[mid+1:]))
+}
+
+func (v Vector[T]) Len() int {
+	return v.root.len()
+}
+
+// At returns the element at index i. It panics if i is out of range.
+func (v Vector[T]) At(i int) T {
+	v.checkIndex(i)
+	n := v.root
+	for {
+		left := n.left.len()
+		switch {
+		case i < left:
+			n = n.left
+		case i > left:
+			i -= left + 1
+			n = n.right
+		default:
+			return n.value
+		}
+	}
+}
+
+// Set returns a vector with the element at index i replaced by x.
+func (v Vector[T]) Set(i int, x T) Vector[T] {
+	v.checkIndex(i)
+	return Vector[T]{root: v.root.set(i, x)}
+}
+
+// Push returns a vector with x added at the end.
+func (v Vector[T]) Push(x ...T) Vector[T] {
+	root := v.root
+	for _, e := range x {
+		root = root.insert(root.len(), e)
+	}
+
+	return Vector[T]{root: root}
+}
+
+// Unshift returns a vector with x added at the beginning, in the order given.
+func (v Vector[T]) Unshift(x ...T) Vector[T] {
+	root := v.root
+	for i := len(x) - 1; i >= 0; i-- {
+		root = root.insert(0, x[i])
+	}
+
+	return Vector[T]{root: root}
+}
+
+// Pop returns the last element and a vector without it. It panics if the vector is empty.
+func (v Vector[T]) Pop() (T, Vector[T]) {
+	if v.Len() == 0 {
+		panic("cannot Pop an empty vector")
+	}
+	last, root := v.root.remove(v.Len() - 1)
+	return last, Vector[T]{root: root}
+}
+
+// Shift returns the first element and a vector without it. It panics if the vector is empty.
+func (v Vector[T]) Shift() (T, Vector[T]) {
+	if v.Len() == 0 {
+		panic("cannot Shift an empty vector")
+	}
+	first, root := v.root.remove(0)
+	return first, Vector[T]{root: root}
+}
+
+// All iterates over the indexes and elements in order.
+func (v Vector[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		v.root.walk(func(x T) bool {
+			ok := yield(i, x)
+			i++
+			return ok
+		})
+	}
+}
+
+// Values iterates over the elements in order.
+func (v Vector[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		v.root.walk(yield)
+	}
+}
+
+// Slice copies the elements into a new slice.
+func (v Vector[T]) Slice() []T {
+	w := make([]T, 0, v.Len())
+	v.root.walk(func(x T) bool {
+		w = append(w, x)
+		return true
+	})
+
+	return w
+}
+
+func (v Vector[T]) Array() Array[T] {
+	return v.Slice()
+}
+
+func (v Vector[T]) checkIndex(i int) {
+	if i < 0 || i >= v.Len() {
+		panic("vector index out of range")
+	}
+}
+
+func (n *vectorNode[T]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *vectorNode[T]) depth() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func newVectorNode[T any](left *vectorNode[T], value T, right *vectorNode[T]) *vectorNode[T] {
+	return &vectorNode[T]{
+		left:   left,
+		right:  right,
+		value:  value,
+		size:   left.len() + right.len() + 1,
+		height: max(left.depth(), right.depth()) + 1,
+	}
+}
+
+// balanceVectorNode builds a node from subtrees whose heights differ by at most
+// two, rotating so that they differ by at most one.
+func balanceVectorNode[T any](left *vectorNode[T], value T, right *vectorNode[T]) *vectorNode[T] {
+	switch {
+	case left.depth() > right.depth()+1:
+		if left.left.depth() >= left.right.depth() {
+			return newVectorNode(left.left, left.value, newVectorNode(left.right, value, right))
+		}
+		lr := left.right
+		return newVectorNode(newVectorNode(left.left, left.value, lr.left), lr.value, newVectorNode(lr.right, value, right))
+	case right.depth() > left.depth()+1:
+		if right.right.depth() >= right.left.depth() {
+			return newVectorNode(newVectorNode(left, value, right.left), right.value, right.right)
+		}
+		rl := right.left
+		return newVectorNode(newVectorNode(left, value, rl.left), rl.value, newVectorNode(rl.right, right.value, right.right))
+	}
+
+	return newVectorNode(left, value, right)
+}
+
+func (n *vectorNode[T]) insert(i int, x T) *vectorNode[T] {
+	if n == nil {
+		return newVectorNode(nil, x, nil)
+	}
+	left := n.left.len()
+	if i <= left {
+		return balanceVectorNode(n.left.insert(i, x), n.value, n.right)
+	}
+
+	return balanceVectorNode(n.left, n.value, n.right.insert(i-left-1, x))
+}
+
+func (n *vectorNode[T]) remove(i int) (T, *vectorNode[T]) {
+	left := n.left.len()
+	switch {
+	case i < left:
+		x, l := n.left.remove(i)
+		return x, balanceVectorNode(l, n.value, n.right)
+	case i > left:
+		x, r := n.right.remove(i - left - 1)
+		return x, balanceVectorNode(n.left, n.value, r)
+	case n.left == nil:
+		return n.value, n.right
+	case n.right == nil:
+		return n.value, n.left
+	}
+
+	next, r := n.right.remove(0)
+	return n.value, balanceVectorNode(n.left, next, r)
+}
+
+func (n *vectorNode[T]) set(i int, x T) *vectorNode[T] {
+	left := n.left.len()
+	switch {
+	case i < left:
+		return newVectorNode(n.left.set(i, x), n.value, n.right)
+	case i > left:
+		return newVectorNode(n.left, n.value, n.right.set(i-left-1, x))
+	}
+
+	return newVectorNode(n.left, x, n.right)
+}
+
+func (n *vectorNode[T]) walk(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	return n.left.walk(yield) && yield(n.value) && n.right.walk(yield)
+}
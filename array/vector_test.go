@@ -0,0 +1,186 @@
+package array_test
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+func TestVector(t *testing.T) {
+	t.Run("test operations keep the receiver", func(t *testing.T) {
+		base := array.NewVector(1, 2, 3)
+		pushed := base.Push(4, 5)
+		unshifted := base.Unshift(-1, 0)
+		last, popped := base.Pop()
+		first, shifted := base.Shift()
+		set := base.Set(1, 20)
+
+		if got := base.Slice(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+			t.Error("base changed. Got", got)
+		}
+		if got := pushed.Slice(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+			t.Error("Push failed. Got", got)
+		}
+		if got := unshifted.Slice(); !reflect.DeepEqual(got, []int{-1, 0, 1, 2, 3}) {
+			t.Error("Unshift failed. Got", got)
+		}
+		if got := popped.Slice(); last != 3 || !reflect.DeepEqual(got, []int{1, 2}) {
+			t.Error("Pop failed. Got", last, got)
+		}
+		if got := shifted.Slice(); first != 1 || !reflect.DeepEqual(got, []int{2, 3}) {
+			t.Error("Shift failed. Got", first, got)
+		}
+		if got := set.Slice(); !reflect.DeepEqual(got, []int{1, 20, 3}) || set.At(1) != 20 {
+			t.Error("Set failed. Got", got)
+		}
+	})
+
+	t.Run("test zero value and conversions", func(t *testing.T) {
+		var v array.Vector[string]
+		if v.Len() != 0 || len(v.Slice()) != 0 {
+			t.Error("zero value failed. Got", v.Slice())
+		}
+		v = array.Array[string]{"a", "b"}.Vector().Push("c")
+		if got := v.Array(); !reflect.DeepEqual(got, array.Array[string]{"a", "b", "c"}) {
+			t.Error("Array failed. Got", got)
+		}
+
+		var indexes []int
+		for i, x := range v.All() {
+			if x == "c" {
+				break
+			}
+			indexes = append(indexes, i)
+		}
+		if !reflect.DeepEqual(indexes, []int{0, 1}) {
+			t.Error("All failed. Got", indexes)
+		}
+		if got := slices.Collect(v.Values()); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+			t.Error("Values failed. Got", got)
+		}
+	})
+
+	t.Run("test panics", func(t *testing.T) {
+		for name, f := range map[string]func(){
+			"Pop":   func() { array.Vector[int]{}.Pop() },
+			"Shift": func() { array.Vector[int]{}.Shift() },
+			"At":    func() { array.NewVector(1).At(1) },
+			"Set":   func() { array.NewVector(1).Set(-1, 0) },
+		} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Error(name, "should panic")
+					}
+				}()
+				f()
+			}()
+		}
+	})
+
+	t.Run("test random operations against a slice", func(t *testing.T) {
+		r := rand.New(rand.NewPCG(1, 2))
+		var v array.Vector[int]
+		var model []int
+		versions := map[int][]int{}
+		saved := map[int]array.Vector[int]{}
+		for step := 0; step < 5000; step++ {
+			switch op := r.IntN(5); {
+			case op == 0:
+				v, model = v.Push(step), append(model, step)
+			case op == 1:
+				v, model = v.Unshift(step), append([]int{step}, model...)
+			case op == 2 && len(model) > 0:
+				var x int
+				x, v = v.Pop()
+				if x != model[len(model)-1] {
+					t.Fatal("Pop failed at step", step)
+				}
+				model = model[:len(model)-1]
+			case op == 3 && len(model) > 0:
+				var x int
+				x, v = v.Shift()
+				if x != model[0] {
+					t.Fatal("Shift failed at step", step)
+				}
+				model = model[1:]
+			case op == 4 && len(model) > 0:
+				i := r.IntN(len(model))
+				v = v.Set(i, -step)
+				model = slices.Clone(model)
+				model[i] = -step
+			}
+			if step%500 == 0 {
+				versions[step] = slices.Clone(model)
+				saved[step] = v
+			}
+		}
+
+		if !slices.Equal(v.Slice(), model) {
+			t.Error("vector and slice differ. Got", v.Slice(), "Expected", model)
+		}
+		for step, exp := range versions {
+			if got := saved[step].Slice(); !slices.Equal(got, exp) {
+				t.Error("version", step, "changed. Got", got, "Expected", exp)
+			}
+		}
+	})
+
+	t.Run("test concurrent pushes from the same base", func(t *testing.T) {
+		base := array.NewVector(0, 1, 2)
+		results := make([][]int, 8)
+		var wg sync.WaitGroup
+		for g := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v := base
+				for i := 0; i < 100; i++ {
+					v = v.Push(g)
+				}
+				results[g] = v.Slice()
+			}()
+		}
+		wg.Wait()
+
+		for g, got := range results {
+			if len(got) != 103 || got[3] != g || got[102] != g {
+				t.Error("goroutine", g, "got", got[:4])
+			}
+		}
+	})
+}
+
+func TestPushPopNoAliasing(t *testing.T) {
+	base := make([]int, 3, 10)
+	copy(base, []int{1, 2, 3})
+
+	a := array.Push(base, 4)
+	b := array.Push(base, 5)
+	if a[3] != 4 || b[3] != 5 {
+		t.Error("Push aliased its input. Got", a, b)
+	}
+
+	_, rest := array.Pop(base)
+	rest = append(rest, 9)
+	if base[2] != 3 {
+		t.Error("Pop aliased its input. Got", base)
+	}
+
+	_, rest = array.Shift(base)
+	rest[0] = 9
+	if base[1] != 2 {
+		t.Error("Shift aliased its input. Got", base)
+	}
+
+	front := make([]int, 1, 10)
+	c := array.Unshift(base, front...)
+	d := array.Unshift([]int{7}, front...)
+	if !reflect.DeepEqual(c, []int{0, 1, 2, 3}) || !reflect.DeepEqual(d, []int{0, 7}) {
+		t.Error("Unshift aliased its input. Got", c, d)
+	}
+}
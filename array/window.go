@@ -0,0 +1,176 @@
+package array
+
+import (
+	"cmp"
+	"slices"
+)
+
+// WindowRow pairs a row with the value computed for it by a window function.
+type WindowRow[T any, V any] struct {
+	Row   T
+	Value V
+}
+
+// NoPartition puts every row in the same partition.
+func NoPartition[T any](T) struct{} {
+	return struct{}{}
+}
+
+// windowPartitions returns the indexes of w for each partition, in order of
+// first appearance, sorted by the order key. Ties keep their input order.
+func windowPartitions[T any, K comparable, O cmp.Ordered](w []T, partition func(T) K, order func(T) O) ([][]int, []O) {
+	keys := make([]O, len(w))
+	positions := make(map[K]int)
+	var parts [][]int
+	for i, x := range w {
+		keys[i] = order(x)
+		k := partition(x)
+		p, ok := positions[k]
+		if !ok {
+			p = len(parts)
+			positions[k] = p
+			parts = append(parts, nil)
+		}
+		parts[p] = append(parts[p], i)
+	}
+	for _, part := range parts {
+		slices.SortStableFunc(part, func(a, b int) int {
+			return cmp.Compare(keys[a], keys[b])
+		})
+	}
+
+	return parts, keys
+}
+
+func window[T any, K comparable, O cmp.Ordered, V any](w []T, partition func(T) K, order func(T) O, f func(part []int, keys []O, out []V)) []WindowRow[T, V] {
+	parts, keys := windowPartitions(w, partition, order)
+	values := make([]V, len(w))
+	for _, part := range parts {
+		f(part, keys, values)
+	}
+
+	result := make([]WindowRow[T, V], len(w))
+	for i, x := range w {
+		result[i] = WindowRow[T, V]{Row: x, Value: values[i]}
+	}
+
+	return result
+}
+
+/* RowNumber numbers the rows of each partition from 1, following the order key.
+* Rows with the same order key keep their input order. The result keeps the
+* input order of w; this applies to every window function.
+* Example:
+*   numbered := RowNumber(sales,
+*       func(s Sale) string { return s.Category },
+*       func(s Sale) float64 { return -s.Total },
+*   )
+*   fmt.Println(numbered[0].Row, numbered[0].Value)
+ */
+func RowNumber[T any, K comparable, O cmp.Ordered](w []T, partition func(T) K, order func(T) O) []WindowRow[T, int] {
+	return window(w, partition, order, func(part []int, _ []O, out []int) {
+		for n, i := range part {
+			out[i] = n + 1
+		}
+	})
+}
+
+// Rank ranks the rows of each partition; ties share a rank and leave gaps (1, 1, 3).
+func Rank[T any, K comparable, O cmp.Ordered](w []T, partition func(T) K, order func(T) O) []WindowRow[T, int] {
+	return window(w, partition, order, func(part []int, keys []O, out []int) {
+		for n, i := range part {
+			if n > 0 && keys[i] == keys[part[n-1]] {
+				out[i] = out[part[n-1]]
+				continue
+			}
+			out[i] = n + 1
+		}
+	})
+}
+
+// DenseRank ranks the rows of each partition; ties share a rank without gaps (1, 1, 2).
+func DenseRank[T any, K comparable, O cmp.Ordered](w []T, partition func(T) K, order func(T) O) []WindowRow[T, int] {
+	return window(w, partition, order, func(part []int, keys []O, out []int) {
+		rank := 0
+		for n, i := range part {
+			if n == 0 || keys[i] != keys[part[n-1]] {
+				rank++
+			}
+			out[i] = rank
+		}
+	})
+}
+
+// PercentRank returns (rank - 1) / (rows in partition - 1), or 0 for single row partitions.
+func PercentRank[T any, K comparable, O cmp.Ordered](w []T, partition func(T) K, order func(T) O) []WindowRow[T, float64] {
+	ranks := Rank(w, partition, order)
+	sizes := GroupCountBy(w, partition)
+	result := make([]WindowRow[T, float64], len(w))
+	for i, r := range ranks {
+		result[i].Row = r.Row
+		if n := sizes[partition(r.Row)]; n > 1 {
+			result[i].Value = float64(r.Value-1) / float64(n-1)
+		}
+	}
+
+	return result
+}
+
+/* Lag returns the value of the row offset positions before each row in its
+* partition, or fallback when there is no such row.
+* Example:
+*   previous := Lag(revenue,
+*       func(r Revenue) string { return r.Region },
+*       func(r Revenue) string { return r.Month },
+*       func(r Revenue) float64 { return r.Total },
+*       1, 0,
+*   )
+*   change := previous[0].Row.Total - previous[0].Value
+ */
+func Lag[T any, K comparable, O cmp.Ordered, V any](w []T, partition func(T) K, order func(T) O, value func(T) V, offset int, fallback V) []WindowRow[T, V] {
+	return window(w, partition, order, func(part []int, _ []O, out []V) {
+		for n, i := range part {
+			if j := n - offset; j >= 0 && j < len(part) {
+				out[i] = value(w[part[j]])
+				continue
+			}
+			out[i] = fallback
+		}
+	})
+}
+
+// Lead returns the value of the row offset positions after each row in its
+// partition, or fallback when there is no such row.
+func Lead[T any, K comparable, O cmp.Ordered, V any](w []T, partition func(T) K, order func(T) O, value func(T) V, offset int, fallback V) []WindowRow[T, V] {
+	return Lag(w, partition, order, value, -offset, fallback)
+}
+
+// CumSum returns the running total of value in each partition, row by row.
+func CumSum[T any, K comparable, O cmp.Ordered, V Number](w []T, partition func(T) K, order func(T) O, value func(T) V) []WindowRow[T, V] {
+	return window(w, partition, order, func(part []int, _ []O, out []V) {
+		var total V
+		for _, i := range part {
+			total += value(w[i])
+			out[i] = total
+		}
+	})
+}
+
+// MovingAvg returns the average of value over each row and up to n-1 rows
+// before it in its partition.
+func MovingAvg[T any, K comparable, O cmp.Ordered, V Number](w []T, partition func(T) K, order func(T) O, value func(T) V, n int) []WindowRow[T, float64] {
+	if n <= 0 {
+		panic("window size must be positive")
+	}
+
+	return window(w, partition, order, func(part []int, _ []O, out []float64) {
+		var sum float64
+		for p, i := range part {
+			sum += float64(value(w[i]))
+			if p >= n {
+				sum -= float64(value(w[part[p-n]]))
+			}
+			out[i] = sum / float64(min(p+1, n))
+		}
+	})
+}
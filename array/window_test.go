@@ -0,0 +1,88 @@
+package array_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devalexandre/gofn/array"
+)
+
+type sale struct {
+	Category string
+	Month    int
+	Total    int
+}
+
+var windowSales = []sale{
+	{"books", 2, 30},
+	{"games", 1, 50},
+	{"books", 1, 10},
+	{"books", 3, 30},
+	{"games", 2, 20},
+	{"books", 4, 40},
+}
+
+func saleCategory(s sale) string { return s.Category }
+func saleMonth(s sale) int       { return s.Month }
+func saleTotal(s sale) int       { return s.Total }
+
+func windowValues[T, V any](rows []array.WindowRow[T, V]) []V {
+	return array.Map(rows, func(r array.WindowRow[T, V]) V { return r.Value })
+}
+
+func TestRowNumber(t *testing.T) {
+	got := array.RowNumber(windowSales, saleCategory, saleMonth)
+	if !reflect.DeepEqual(windowValues(got), []int{2, 1, 1, 3, 2, 4}) {
+		t.Error("RowNumber failed. Got", windowValues(got))
+	}
+	if got[1].Row != windowSales[1] {
+		t.Error("RowNumber failed. Got", got[1].Row, "Expected", windowSales[1])
+	}
+
+	all := array.RowNumber(windowSales, array.NoPartition[sale], saleMonth)
+	if !reflect.DeepEqual(windowValues(all), []int{3, 1, 2, 5, 4, 6}) {
+		t.Error("RowNumber failed. Got", windowValues(all))
+	}
+}
+
+func TestRank(t *testing.T) {
+	rank := array.Rank(windowSales, saleCategory, saleTotal)
+	if !reflect.DeepEqual(windowValues(rank), []int{2, 2, 1, 2, 1, 4}) {
+		t.Error("Rank failed. Got", windowValues(rank))
+	}
+
+	dense := array.DenseRank(windowSales, saleCategory, saleTotal)
+	if !reflect.DeepEqual(windowValues(dense), []int{2, 2, 1, 2, 1, 3}) {
+		t.Error("DenseRank failed. Got", windowValues(dense))
+	}
+
+	percent := array.PercentRank(windowSales, saleCategory, saleTotal)
+	expected := []float64{1.0 / 3, 1, 0, 1.0 / 3, 0, 1}
+	if !reflect.DeepEqual(windowValues(percent), expected) {
+		t.Error("PercentRank failed. Got", windowValues(percent), "Expected", expected)
+	}
+}
+
+func TestLagLead(t *testing.T) {
+	lag := array.Lag(windowSales, saleCategory, saleMonth, saleTotal, 1, -1)
+	if !reflect.DeepEqual(windowValues(lag), []int{10, -1, -1, 30, 50, 30}) {
+		t.Error("Lag failed. Got", windowValues(lag))
+	}
+
+	lead := array.Lead(windowSales, saleCategory, saleMonth, saleTotal, 2, 0)
+	if !reflect.DeepEqual(windowValues(lead), []int{40, 0, 30, 0, 0, 0}) {
+		t.Error("Lead failed. Got", windowValues(lead))
+	}
+}
+
+func TestCumSumMovingAvg(t *testing.T) {
+	sum := array.CumSum(windowSales, saleCategory, saleMonth, saleTotal)
+	if !reflect.DeepEqual(windowValues(sum), []int{40, 50, 10, 70, 70, 110}) {
+		t.Error("CumSum failed. Got", windowValues(sum))
+	}
+
+	avg := array.MovingAvg(windowSales, saleCategory, saleMonth, saleTotal, 2)
+	if !reflect.DeepEqual(windowValues(avg), []float64{20, 50, 10, 30, 35, 35}) {
+		t.Error("MovingAvg failed. Got", windowValues(avg))
+	}
+}
@@ -3,7 +3,9 @@ package pipe
 import (
 	"cmp"
 	"fmt"
+	"math/rand/v2"
 	"slices"
+	"time"
 
 	"github.com/devalexandre/gofn/array"
 )
@@ -24,6 +26,100 @@ func Filter[T any](f func(T) bool) func([]T) ([]T, error) {
 	}
 }
 
+// Find adapts the find function for pipeline use.
+func Find[T any](f func(T) bool) func([]T) (T, error) {
+	return func(a []T) (T, error) {
+		return array.Find(a, f), nil
+	}
+}
+
+// FindOk adapts the findOk function for pipeline use. A miss is reported as an error.
+func FindOk[T any](f func(T) bool) func([]T) (T, error) {
+	return func(a []T) (T, error) {
+		x, ok := array.FindOk(a, f)
+		if !ok {
+			return x, fmt.Errorf("no element matches")
+		}
+		return x, nil
+	}
+}
+
+// FindOption adapts the findOption function for pipeline use. A miss is None, not an error.
+func FindOption[T any](f func(T) bool) func([]T) (array.Option[T], error) {
+	return func(a []T) (array.Option[T], error) {
+		return array.FindOption(a, f), nil
+	}
+}
+
+// IndexOfOption adapts the indexOfOption function for pipeline use.
+func IndexOfOption[T comparable](x T) func([]T) (array.Option[int], error) {
+	return func(a []T) (array.Option[int], error) {
+		return array.IndexOfOption(a, x), nil
+	}
+}
+
+// ReduceOption adapts the reduceOption function for pipeline use.
+func ReduceOption[T any](f func(T, T) T) func([]T) (array.Option[T], error) {
+	return func(a []T) (array.Option[T], error) {
+		return array.ReduceOption(a, f), nil
+	}
+}
+
+// SumOption adapts the sumOption function for pipeline use.
+func SumOption[T Number]() func([]T) (array.Option[T], error) {
+	return func(a []T) (array.Option[T], error) {
+		return array.SumOption(a), nil
+	}
+}
+
+// ProductOption adapts the productOption function for pipeline use.
+func ProductOption[T Number]() func([]T) (array.Option[T], error) {
+	return func(a []T) (array.Option[T], error) {
+		return array.ProductOption(a), nil
+	}
+}
+
+// MinOption adapts the minOption function for pipeline use.
+func MinOption[T Number]() func([]T) (array.Option[T], error) {
+	return func(a []T) (array.Option[T], error) {
+		return array.MinOption(a), nil
+	}
+}
+
+// MaxOption adapts the maxOption function for pipeline use.
+func MaxOption[T Number]() func([]T) (array.Option[T], error) {
+	return func(a []T) (array.Option[T], error) {
+		return array.MaxOption(a), nil
+	}
+}
+
+// AvgOption adapts the avgOption function for pipeline use.
+func AvgOption[T Number]() func([]T) (array.Option[float64], error) {
+	return func(a []T) (array.Option[float64], error) {
+		return array.AvgOption(a), nil
+	}
+}
+
+/* Lift turns a function that returns a Result into a stage that maps the input.
+* The stage stops at the first failed Result and returns its error.
+* Example:
+*   parse := func(s string) array.Result[int] { return array.ResultOf(strconv.Atoi(s)) }
+*   numbers, err := Lift(parse)([]string{"1", "2", "x"}) // err: element 2: strconv.Atoi: ...
+ */
+func Lift[T, U any](f func(T) array.Result[U]) func([]T) ([]U, error) {
+	return func(a []T) ([]U, error) {
+		result := make([]U, len(a))
+		for i, x := range a {
+			u, err := f(x).Unpack()
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			result[i] = u
+		}
+		return result, nil
+	}
+}
+
 // Map adapts the map function for pipeline use.
 func Map[T any, U any](f func(T) U) func([]T) ([]U, error) {
 	return func(a []T) ([]U, error) {
@@ -31,6 +127,20 @@ func Map[T any, U any](f func(T) U) func([]T) ([]U, error) {
 	}
 }
 
+// FlatMap adapts the flatMap function for pipeline use.
+func FlatMap[T any, U any](f func(T) []U) func([]T) ([]U, error) {
+	return func(a []T) ([]U, error) {
+		return array.FlatMap(a, f), nil
+	}
+}
+
+// Pluck adapts the pluck function for pipeline use.
+func Pluck[T any, V Number](f func(T) V) func([]T) (array.Numbers[V], error) {
+	return func(a []T) (array.Numbers[V], error) {
+		return array.Pluck(a, f), nil
+	}
+}
+
 // Reduce adapts the reduce function for pipeline use.
 func Reduce[T any](f func(T, T) T) func([]T) (T, error) {
 	return func(a []T) (T, error) {
@@ -42,9 +152,48 @@ func Reduce[T any](f func(T, T) T) func([]T) (T, error) {
 	}
 }
 
+// Fold adapts the fold function for pipeline use.
+func Fold[T any, A any](init A, f func(A, T) A) func([]T) (A, error) {
+	return func(a []T) (A, error) {
+		return array.Fold(a, init, f), nil
+	}
+}
+
+// Zip adapts the zip function for pipeline use, pairing the input with b.
+func Zip[T any, U any, R any](b []U, f func(T, U) R) func([]T) ([]R, error) {
+	return func(a []T) ([]R, error) {
+		return array.Zip(a, b, f), nil
+	}
+}
+
+// Any adapts the any function for pipeline use.
+func Any[T any](f func(T) bool) func([]T) (bool, error) {
+	return func(a []T) (bool, error) {
+		return array.Any(a, f), nil
+	}
+}
+
+// Some adapts the some function for pipeline use.
+func Some[T any](f func(T) bool) func([]T) (bool, error) {
+	return func(a []T) (bool, error) {
+		return array.Some(a, f), nil
+	}
+}
+
+// Every adapts the every function for pipeline use.
+func Every[T any](f func(T) bool) func([]T) (bool, error) {
+	return func(a []T) (bool, error) {
+		return array.Every(a, f), nil
+	}
+}
+
 // Sum adapts the sum function for pipeline use.
 func Sum[T Number]() func([]T) (T, error) {
 	return func(a []T) (T, error) {
+		if len(a) == 0 {
+			var zero T
+			return zero, fmt.Errorf("slice is empty, cannot determine Sum")
+		}
 		return array.Sum(a), nil
 	}
 }
@@ -52,10 +201,31 @@ func Sum[T Number]() func([]T) (T, error) {
 // Product adapts the product function for pipeline use.
 func Product[T Number]() func([]T) (T, error) {
 	return func(a []T) (T, error) {
+		if len(a) == 0 {
+			var zero T
+			return zero, fmt.Errorf("slice is empty, cannot determine Product")
+		}
 		return array.Product(a), nil
 	}
 }
 
+// Avg adapts the avg function for pipeline use.
+func Avg[T Number]() func([]T) (float64, error) {
+	return func(a []T) (float64, error) {
+		if len(a) == 0 {
+			return 0, fmt.Errorf("slice is empty, cannot determine Avg")
+		}
+		return array.Avg(a), nil
+	}
+}
+
+// Stats adapts the stats function for pipeline use.
+func Stats[T Number]() func([]T) (array.GroupStats[T], error) {
+	return func(a []T) (array.GroupStats[T], error) {
+		return array.Stats(a), nil
+	}
+}
+
 func Min[T Number]() func([]T) (T, error) {
 	return func(a []T) (T, error) {
 		if len(a) == 0 {
@@ -97,6 +267,176 @@ func Shuffle[T any]() func([]T) ([]T, error) {
 	}
 }
 
+// ShuffleWith adapts the shuffleWith function for pipeline use.
+func ShuffleWith[T any](r *rand.Rand) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.ShuffleWith(a, r), nil
+	}
+}
+
+// Sample adapts the sample function for pipeline use.
+func Sample[T any](n int, r *rand.Rand) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.Sample(a, n, r), nil
+	}
+}
+
+// SampleWithReplacement adapts the sampleWithReplacement function for pipeline use.
+func SampleWithReplacement[T any](n int, r *rand.Rand) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		if len(a) == 0 && n > 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.SampleWithReplacement(a, n, r), nil
+	}
+}
+
+// WeightedSample adapts the weightedSample function for pipeline use.
+func WeightedSample[T any](n int, weight func(T) float64, r *rand.Rand) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.WeightedSample(a, n, weight, r), nil
+	}
+}
+
+// ReservoirSample adapts the reservoirSample function for pipeline use.
+func ReservoirSample[T any](n int, r *rand.Rand) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		if n < 0 {
+			return nil, fmt.Errorf("n must not be negative")
+		}
+		return array.ReservoirSample(slices.Values(a), n, r), nil
+	}
+}
+
+// TopK adapts the topK function for pipeline use.
+func TopK[T any](k int, compare func(a, b T) int) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		if k < 0 {
+			return nil, fmt.Errorf("k must not be negative")
+		}
+		return array.TopK(a, k, compare), nil
+	}
+}
+
+// TopKBy adapts the topKBy function for pipeline use.
+func TopKBy[T any, K cmp.Ordered](k int, key func(T) K) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		if k < 0 {
+			return nil, fmt.Errorf("k must not be negative")
+		}
+		return array.TopKBy(a, k, key), nil
+	}
+}
+
+// MergeSorted merges the input slices, each sorted by compare, into one sorted slice.
+func MergeSorted[T any](compare func(a, b T) int) func([][]T) ([]T, error) {
+	return func(lists [][]T) ([]T, error) {
+		return array.MergeSorted(compare, lists...), nil
+	}
+}
+
+// Schedule adapts the schedule function for pipeline use.
+func Schedule[T any, V Number](workers int, cost func(T) V) func([]T) ([][]T, error) {
+	return func(a []T) ([][]T, error) {
+		if workers <= 0 {
+			return nil, fmt.Errorf("workers must be positive")
+		}
+		return array.Schedule(a, workers, cost), nil
+	}
+}
+
+// BinarySearchBy adapts the binarySearchBy function for pipeline use; the
+// position and whether the key was found come in a single Pair.
+func BinarySearchBy[T any, K cmp.Ordered](target K, key func(T) K) func([]T) (array.Pair[int, bool], error) {
+	return func(a []T) (array.Pair[int, bool], error) {
+		return array.PairOf(array.BinarySearchBy(a, target, key)), nil
+	}
+}
+
+// LowerBound adapts the lowerBound function for pipeline use.
+func LowerBound[T any, K cmp.Ordered](target K, key func(T) K) func([]T) (int, error) {
+	return func(a []T) (int, error) {
+		return array.LowerBound(a, target, key), nil
+	}
+}
+
+// UpperBound adapts the upperBound function for pipeline use.
+func UpperBound[T any, K cmp.Ordered](target K, key func(T) K) func([]T) (int, error) {
+	return func(a []T) (int, error) {
+		return array.UpperBound(a, target, key), nil
+	}
+}
+
+// EqualRange adapts the equalRange function for pipeline use; the bounds come
+// in a single Pair.
+func EqualRange[T any, K cmp.Ordered](target K, key func(T) K) func([]T) (array.Pair[int, int], error) {
+	return func(a []T) (array.Pair[int, int], error) {
+		return array.PairOf(array.EqualRange(a, target, key)), nil
+	}
+}
+
+// RangeBy adapts the rangeBy function for pipeline use.
+func RangeBy[T any, K cmp.Ordered](from, to K, key func(T) K) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.RangeBy(a, from, to, key), nil
+	}
+}
+
+// Union adapts the union function for pipeline use, appending b to the input.
+func Union[T any](b []T) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.Union(a, b), nil
+	}
+}
+
+// Fill adapts the fill function for pipeline use.
+func Fill[T any](start, end int, x T) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.Fill(a, start, end, x), nil
+	}
+}
+
+// Slice adapts the slice function for pipeline use.
+func Slice[T any](start, end int) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.Slice(a, start, end), nil
+	}
+}
+
+// Splice adapts the splice function for pipeline use; it returns the new slice and the removed elements.
+func Splice[T any](start, deleteCount int, items ...T) func([]T) ([]T, []T, error) {
+	return func(a []T) ([]T, []T, error) {
+		b, removed := array.Splice(a, start, deleteCount, items...)
+		return b, removed, nil
+	}
+}
+
+// SplicePair is Splice with the new slice and the removed elements in a single Pair output.
+func SplicePair[T any](start, deleteCount int, items ...T) func([]T) (array.Pair[[]T, []T], error) {
+	return pairStage(Splice(start, deleteCount, items...))
+}
+
+// InsertAt adapts the insertAt function for pipeline use.
+func InsertAt[T any](i int, items ...T) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.InsertAt(a, i, items...), nil
+	}
+}
+
+// RemoveAt adapts the removeAt function for pipeline use.
+func RemoveAt[T any](i int) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.RemoveAt(a, i), nil
+	}
+}
+
+// RemoveWhere adapts the removeWhere function for pipeline use.
+func RemoveWhere[T any](f func(T) bool) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.RemoveWhere(a, f), nil
+	}
+}
+
 // Join adapts the join function for pipeline use.
 func Join[T any](sep string) func([]T) (string, error) {
 	return func(a []T) (string, error) {
@@ -118,6 +458,62 @@ func IndexOf[T comparable](element T) func([]T) (int, error) {
 	}
 }
 
+// Equals adapts the equals function for pipeline use.
+func Equals[T comparable](b []T) func([]T) (bool, error) {
+	return func(a []T) (bool, error) {
+		return array.Equals(a, b), nil
+	}
+}
+
+// Intersect adapts the intersect function for pipeline use.
+func Intersect[T comparable](b []T) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.Intersect(a, b), nil
+	}
+}
+
+// Difference adapts the difference function for pipeline use.
+func Difference[T comparable](b []T) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		return array.Difference(a, b), nil
+	}
+}
+
+// Count adapts the count function for pipeline use.
+func Count[T comparable](element T) func([]T) (int, error) {
+	return func(a []T) (int, error) {
+		return array.Count(a, element), nil
+	}
+}
+
+// Frequencies adapts the frequencies function for pipeline use.
+func Frequencies[T comparable]() func([]T) (map[T]int, error) {
+	return func(a []T) (map[T]int, error) {
+		return array.Frequencies(a), nil
+	}
+}
+
+// FrequenciesOrdered adapts the frequenciesOrdered function for pipeline use.
+func FrequenciesOrdered[T comparable]() func([]T) (*array.OrderedMap[T, int], error) {
+	return func(a []T) (*array.OrderedMap[T, int], error) {
+		return array.FrequenciesOrdered(a), nil
+	}
+}
+
+// ToSet adapts the toSet function for pipeline use.
+func ToSet[T comparable]() func([]T) (array.Set[T], error) {
+	return func(a []T) (array.Set[T], error) {
+		return array.ToSet(a), nil
+	}
+}
+
+// ToSetBy adapts the toSetBy function for pipeline use.
+func ToSetBy[T any, K comparable](key func(T) K) func([]T) (array.Set[K], error) {
+	return func(a []T) (array.Set[K], error) {
+		return array.ToSetBy(a, key), nil
+	}
+}
+
 // ForEach adapts the forEach function for pipeline use.
 // Nota: Embora ForEach não retorne um valor, para manter a assinatura consistente,
 // retornaremos a própria slice e nil para o erro.
@@ -140,6 +536,12 @@ func Pop[T any]() func([]T) (T, []T, error) {
 	}
 }
 
+// PopPair is Pop with the element and the rest in a single Pair output, so it
+// can be chained like any other stage.
+func PopPair[T any]() func([]T) (array.Pair[T, []T], error) {
+	return pairStage(Pop[T]())
+}
+
 // Push adapts the push function for pipeline use.
 func Push[T any](elements ...T) func([]T) ([]T, error) {
 	return func(a []T) ([]T, error) {
@@ -159,6 +561,11 @@ func Shift[T any]() func([]T) (T, []T, error) {
 	}
 }
 
+// ShiftPair is Shift with the element and the rest in a single Pair output.
+func ShiftPair[T any]() func([]T) (array.Pair[T, []T], error) {
+	return pairStage(Shift[T]())
+}
+
 // Sort adapts the sort function for pipeline use.
 // Sort adapts the sort function for pipeline use, requiring a comparison function.
 func Sort[T any](less func(i, j T) bool) func([]T) ([]T, error) {
@@ -188,25 +595,126 @@ func Unshift[T any](elements ...T) func([]T) ([]T, error) {
 	}
 }
 
-func GroupBy[T any, K comparable](f func(T) K) func([]T) ([]T, error) {
-	return func(a []T) ([]T, error) {
+// GroupBy groups the input by key. To keep only the first row of each key, use DistinctBy.
+func GroupBy[T any, K comparable](f func(T) K) func([]T) (map[K][]T, error) {
+	return func(a []T) (map[K][]T, error) {
 		if len(a) == 0 {
 			return nil, fmt.Errorf("the input slice is empty")
 		}
-		seen := make(map[K]struct{}, len(a))
-		result := make([]T, 0, len(a))
-		for _, item := range a {
-			key := f(item)
-			if _, ok := seen[key]; ok {
-				continue
+		return array.GroupBy(a, f), nil
+	}
+}
+
+// GroupByOrdered is GroupBy with the keys in the order they first appear.
+func GroupByOrdered[T any, K comparable](f func(T) K) func([]T) (*array.OrderedMap[K, []T], error) {
+	return func(a []T) (*array.OrderedMap[K, []T], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupByOrdered(a, f), nil
+	}
+}
+
+// Groups adapts the groups function for pipeline use.
+func Groups[T any, K comparable](key func(T) K) func([]T) ([]array.Group[K, T], error) {
+	return func(a []T) ([]array.Group[K, T], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.Groups(a, key), nil
+	}
+}
+
+/* PerGroup runs a nested pipeline on the items of each group and collects the
+* results by key. The first failing group stops the stage.
+* Example:
+*   latest := PerGroup(
+*       func(o Order) string { return o.Customer },
+*       Then(SortBy(func(o Order) int64 { return -o.Date.Unix() }), Take[Order](3)),
+*   )
+*   byCustomer, err := latest(orders)
+ */
+func PerGroup[T any, K comparable, U any](key func(T) K, sub func([]T) (U, error)) func([]T) (map[K]U, error) {
+	return func(a []T) (map[K]U, error) {
+		result := make(map[K]U)
+		for _, g := range array.Groups(a, key) {
+			u, err := sub(g.Items)
+			if err != nil {
+				return nil, fmt.Errorf("group %v: %w", g.Key, err)
+			}
+			result[g.Key] = u
+		}
+		return result, nil
+	}
+}
+
+// PerGroupOrdered is PerGroup with the results in the order their key first appears.
+func PerGroupOrdered[T any, K comparable, U any](key func(T) K, sub func([]T) (U, error)) func([]T) (*array.OrderedMap[K, U], error) {
+	return func(a []T) (*array.OrderedMap[K, U], error) {
+		result := array.NewOrderedMap[K, U]()
+		for _, g := range array.Groups(a, key) {
+			u, err := sub(g.Items)
+			if err != nil {
+				return nil, fmt.Errorf("group %v: %w", g.Key, err)
 			}
-			seen[key] = struct{}{}
-			result = append(result, item)
+			result.Set(g.Key, u)
 		}
 		return result, nil
 	}
 }
 
+// Flatten adapts the flatten function for pipeline use.
+func Flatten[T any]() func([][]T) ([]T, error) {
+	return func(rows [][]T) ([]T, error) {
+		return array.Flatten(rows), nil
+	}
+}
+
+// Transpose adapts the transpose function for pipeline use.
+func Transpose[T any](fill T) func([][]T) ([][]T, error) {
+	return func(rows [][]T) ([][]T, error) {
+		return array.Transpose(rows, fill), nil
+	}
+}
+
+// RotateMatrix adapts the rotateMatrix function for pipeline use.
+func RotateMatrix[T any](turns int, fill T) func([][]T) ([][]T, error) {
+	return func(rows [][]T) ([][]T, error) {
+		return array.RotateMatrix(rows, turns, fill), nil
+	}
+}
+
+// Cartesian adapts the cartesian function for pipeline use, combining the input with others.
+func Cartesian[T any](others ...[]T) func([]T) ([][]T, error) {
+	return func(a []T) ([][]T, error) {
+		return array.Cartesian(append([][]T{a}, others...)...), nil
+	}
+}
+
+// BuildTree adapts the buildTree function for pipeline use. Orphans, cycles
+// and detached items are reported as an *array.TreeError holding the report.
+func BuildTree[T any, K comparable](id func(T) K, parentID func(T) K) func([]T) (array.Forest[T], error) {
+	return func(a []T) (array.Forest[T], error) {
+		roots, report := array.BuildTree(a, id, parentID)
+		if err := report.Err(); err != nil {
+			return nil, err
+		}
+		return roots, nil
+	}
+}
+
+// Then composes two stages into one, so pipelines can be built and nested.
+func Then[A any, B any, C any](first func(A) (B, error), second func(B) (C, error)) func(A) (C, error) {
+	return func(a A) (C, error) {
+		b, err := first(a)
+		if err != nil {
+			var zero C
+			return zero, err
+		}
+		return second(b)
+	}
+}
+
 func GroupSumBy[T any, K comparable, V Number](key func(T) K, value func(T) V) func([]T) (map[K]V, error) {
 	return func(a []T) (map[K]V, error) {
 		if len(a) == 0 {
@@ -216,6 +724,15 @@ func GroupSumBy[T any, K comparable, V Number](key func(T) K, value func(T) V) f
 	}
 }
 
+func GroupSumByOrdered[T any, K comparable, V Number](key func(T) K, value func(T) V) func([]T) (*array.OrderedMap[K, V], error) {
+	return func(a []T) (*array.OrderedMap[K, V], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupSumByOrdered(a, key, value), nil
+	}
+}
+
 func GroupSumByWhere[T any, K comparable, V Number](where func(T) bool, key func(T) K, value func(T) V) func([]T) (map[K]V, error) {
 	return func(a []T) (map[K]V, error) {
 		if len(a) == 0 {
@@ -225,6 +742,15 @@ func GroupSumByWhere[T any, K comparable, V Number](where func(T) bool, key func
 	}
 }
 
+func GroupSumByWhereOrdered[T any, K comparable, V Number](where func(T) bool, key func(T) K, value func(T) V) func([]T) (*array.OrderedMap[K, V], error) {
+	return func(a []T) (*array.OrderedMap[K, V], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupSumByWhereOrdered(a, where, key, value), nil
+	}
+}
+
 func GroupCountBy[T any, K comparable](key func(T) K) func([]T) (map[K]int, error) {
 	return func(a []T) (map[K]int, error) {
 		if len(a) == 0 {
@@ -234,6 +760,21 @@ func GroupCountBy[T any, K comparable](key func(T) K) func([]T) (map[K]int, erro
 	}
 }
 
+func GroupCountByOrdered[T any, K comparable](key func(T) K) func([]T) (*array.OrderedMap[K, int], error) {
+	return func(a []T) (*array.OrderedMap[K, int], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupCountByOrdered(a, key), nil
+	}
+}
+
+func CounterBy[T any, K cmp.Ordered](key func(T) K) func([]T) (array.Counter[K], error) {
+	return func(a []T) (array.Counter[K], error) {
+		return array.CounterBy(a, key), nil
+	}
+}
+
 func GroupReduceBy[T any, K comparable, A any](key func(T) K, reduce func(A, T) A) func([]T) (map[K]A, error) {
 	return func(a []T) (map[K]A, error) {
 		if len(a) == 0 {
@@ -243,6 +784,15 @@ func GroupReduceBy[T any, K comparable, A any](key func(T) K, reduce func(A, T)
 	}
 }
 
+func GroupReduceByOrdered[T any, K comparable, A any](key func(T) K, reduce func(A, T) A) func([]T) (*array.OrderedMap[K, A], error) {
+	return func(a []T) (*array.OrderedMap[K, A], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupReduceByOrdered(a, key, reduce), nil
+	}
+}
+
 func GroupStatsBy[T any, K comparable, V Number](key func(T) K, value func(T) V) func([]T) (map[K]array.GroupStats[V], error) {
 	return func(a []T) (map[K]array.GroupStats[V], error) {
 		if len(a) == 0 {
@@ -252,6 +802,87 @@ func GroupStatsBy[T any, K comparable, V Number](key func(T) K, value func(T) V)
 	}
 }
 
+func GroupStatsByOrdered[T any, K comparable, V Number](key func(T) K, value func(T) V) func([]T) (*array.OrderedMap[K, array.GroupStats[V]], error) {
+	return func(a []T) (*array.OrderedMap[K, array.GroupStats[V]], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupStatsByOrdered(a, key, value), nil
+	}
+}
+
+func GroupByTime[T any](ts func(T) time.Time, bucket array.TimeBucket) func([]T) (map[time.Time][]T, error) {
+	return func(a []T) (map[time.Time][]T, error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupByTime(a, ts, bucket), nil
+	}
+}
+
+func GroupByTimeOrdered[T any](ts func(T) time.Time, bucket array.TimeBucket) func([]T) (*array.OrderedMap[time.Time, []T], error) {
+	return func(a []T) (*array.OrderedMap[time.Time, []T], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupByTimeOrdered(a, ts, bucket), nil
+	}
+}
+
+func GroupSumByTime[T any, V Number](ts func(T) time.Time, value func(T) V, bucket array.TimeBucket) func([]T) (map[time.Time]V, error) {
+	return func(a []T) (map[time.Time]V, error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupSumByTime(a, ts, value, bucket), nil
+	}
+}
+
+func GroupSumByTimeOrdered[T any, V Number](ts func(T) time.Time, value func(T) V, bucket array.TimeBucket) func([]T) (*array.OrderedMap[time.Time, V], error) {
+	return func(a []T) (*array.OrderedMap[time.Time, V], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupSumByTimeOrdered(a, ts, value, bucket), nil
+	}
+}
+
+func GroupCountByTime[T any](ts func(T) time.Time, bucket array.TimeBucket) func([]T) (map[time.Time]int, error) {
+	return func(a []T) (map[time.Time]int, error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupCountByTime(a, ts, bucket), nil
+	}
+}
+
+func GroupCountByTimeOrdered[T any](ts func(T) time.Time, bucket array.TimeBucket) func([]T) (*array.OrderedMap[time.Time, int], error) {
+	return func(a []T) (*array.OrderedMap[time.Time, int], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupCountByTimeOrdered(a, ts, bucket), nil
+	}
+}
+
+func GroupStatsByTime[T any, V Number](ts func(T) time.Time, value func(T) V, bucket array.TimeBucket) func([]T) (map[time.Time]array.GroupStats[V], error) {
+	return func(a []T) (map[time.Time]array.GroupStats[V], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupStatsByTime(a, ts, value, bucket), nil
+	}
+}
+
+func GroupStatsByTimeOrdered[T any, V Number](ts func(T) time.Time, value func(T) V, bucket array.TimeBucket) func([]T) (*array.OrderedMap[time.Time, array.GroupStats[V]], error) {
+	return func(a []T) (*array.OrderedMap[time.Time, array.GroupStats[V]], error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.GroupStatsByTimeOrdered(a, ts, value, bucket), nil
+	}
+}
+
 func DistinctBy[T any, K comparable](key func(T) K) func([]T) ([]T, error) {
 	return func(a []T) ([]T, error) {
 		return array.DistinctBy(a, key), nil
@@ -264,6 +895,12 @@ func IndexBy[T any, K comparable](key func(T) K) func([]T) (map[K]T, error) {
 	}
 }
 
+func IndexByOrdered[T any, K comparable](key func(T) K) func([]T) (*array.OrderedMap[K, T], error) {
+	return func(a []T) (*array.OrderedMap[K, T], error) {
+		return array.IndexByOrdered(a, key), nil
+	}
+}
+
 func Partition[T any](f func(T) bool) func([]T) ([]T, []T, error) {
 	return func(a []T) ([]T, []T, error) {
 		matched, unmatched := array.Partition(a, f)
@@ -271,6 +908,60 @@ func Partition[T any](f func(T) bool) func([]T) ([]T, []T, error) {
 	}
 }
 
+// PartitionPair is Partition with both sides in a single Pair output.
+func PartitionPair[T any](f func(T) bool) func([]T) (array.Pair[[]T, []T], error) {
+	return pairStage(Partition(f))
+}
+
+// KeepMatched keeps the side of the partition that satisfies f.
+func KeepMatched[T any](f func(T) bool) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		matched, _ := array.Partition(a, f)
+		return matched, nil
+	}
+}
+
+// KeepRest keeps the side of the partition that does not satisfy f.
+func KeepRest[T any](f func(T) bool) func([]T) ([]T, error) {
+	return func(a []T) ([]T, error) {
+		_, rest := array.Partition(a, f)
+		return rest, nil
+	}
+}
+
+/* Route partitions the input by f and runs each side through its own stage.
+* It fails with the error of either stage.
+* Example:
+*   isLarge := func(x float64) bool { return x >= 100 }
+*   split := Route(isLarge, Sum[float64](), Avg[float64]())
+*   p, err := split(amounts) // Pair{sum of the large amounts, average of the others}
+ */
+func Route[T, A, B any](f func(T) bool, matched func([]T) (A, error), rest func([]T) (B, error)) func([]T) (array.Pair[A, B], error) {
+	return func(a []T) (array.Pair[A, B], error) {
+		m, r := array.Partition(a, f)
+		first, err := matched(m)
+		if err != nil {
+			return array.Pair[A, B]{}, fmt.Errorf("matched: %w", err)
+		}
+		second, err := rest(r)
+		if err != nil {
+			return array.Pair[A, B]{}, fmt.Errorf("rest: %w", err)
+		}
+		return array.PairOf(first, second), nil
+	}
+}
+
+// pairStage turns a stage with two outputs into one with a single Pair output.
+func pairStage[T, A, B any](stage func([]T) (A, B, error)) func([]T) (array.Pair[A, B], error) {
+	return func(a []T) (array.Pair[A, B], error) {
+		first, second, err := stage(a)
+		if err != nil {
+			return array.Pair[A, B]{}, err
+		}
+		return array.PairOf(first, second), nil
+	}
+}
+
 func SortBy[T any, K cmp.Ordered](key func(T) K) func([]T) ([]T, error) {
 	return func(a []T) ([]T, error) {
 		return array.SortBy(a, key), nil
@@ -294,3 +985,105 @@ func Chunk[T any](size int) func([]T) ([][]T, error) {
 		return array.Chunk(a, size), nil
 	}
 }
+
+func RowNumber[T any, K comparable, O cmp.Ordered](partition func(T) K, order func(T) O) func([]T) ([]array.WindowRow[T, int], error) {
+	return func(a []T) ([]array.WindowRow[T, int], error) {
+		return array.RowNumber(a, partition, order), nil
+	}
+}
+
+func Rank[T any, K comparable, O cmp.Ordered](partition func(T) K, order func(T) O) func([]T) ([]array.WindowRow[T, int], error) {
+	return func(a []T) ([]array.WindowRow[T, int], error) {
+		return array.Rank(a, partition, order), nil
+	}
+}
+
+func DenseRank[T any, K comparable, O cmp.Ordered](partition func(T) K, order func(T) O) func([]T) ([]array.WindowRow[T, int], error) {
+	return func(a []T) ([]array.WindowRow[T, int], error) {
+		return array.DenseRank(a, partition, order), nil
+	}
+}
+
+func PercentRank[T any, K comparable, O cmp.Ordered](partition func(T) K, order func(T) O) func([]T) ([]array.WindowRow[T, float64], error) {
+	return func(a []T) ([]array.WindowRow[T, float64], error) {
+		return array.PercentRank(a, partition, order), nil
+	}
+}
+
+func Lag[T any, K comparable, O cmp.Ordered, V any](partition func(T) K, order func(T) O, value func(T) V, offset int, fallback V) func([]T) ([]array.WindowRow[T, V], error) {
+	return func(a []T) ([]array.WindowRow[T, V], error) {
+		return array.Lag(a, partition, order, value, offset, fallback), nil
+	}
+}
+
+func Lead[T any, K comparable, O cmp.Ordered, V any](partition func(T) K, order func(T) O, value func(T) V, offset int, fallback V) func([]T) ([]array.WindowRow[T, V], error) {
+	return func(a []T) ([]array.WindowRow[T, V], error) {
+		return array.Lead(a, partition, order, value, offset, fallback), nil
+	}
+}
+
+func CumSum[T any, K comparable, O cmp.Ordered, V Number](partition func(T) K, order func(T) O, value func(T) V) func([]T) ([]array.WindowRow[T, V], error) {
+	return func(a []T) ([]array.WindowRow[T, V], error) {
+		return array.CumSum(a, partition, order, value), nil
+	}
+}
+
+func MovingAvg[T any, K comparable, O cmp.Ordered, V Number](partition func(T) K, order func(T) O, value func(T) V, n int) func([]T) ([]array.WindowRow[T, float64], error) {
+	return func(a []T) ([]array.WindowRow[T, float64], error) {
+		if n <= 0 {
+			return nil, fmt.Errorf("window size must be positive")
+		}
+		return array.MovingAvg(a, partition, order, value, n), nil
+	}
+}
+
+func ApproxCountDistinct[T any, K comparable](key func(T) K) func([]T) (uint64, error) {
+	return func(a []T) (uint64, error) {
+		return array.ApproxCountDistinct(a, key), nil
+	}
+}
+
+func GroupHyperLogLogBy[T any, G comparable, K comparable](group func(T) G, key func(T) K, precision uint8) func([]T) (map[G]*array.HyperLogLog[K], error) {
+	return func(a []T) (map[G]*array.HyperLogLog[K], error) {
+		if precision < 4 || precision > 18 {
+			return nil, fmt.Errorf("precision must be between 4 and 18")
+		}
+		return array.GroupHyperLogLogBy(a, group, key, precision), nil
+	}
+}
+
+func GroupHyperLogLogByOrdered[T any, G comparable, K comparable](group func(T) G, key func(T) K, precision uint8) func([]T) (*array.OrderedMap[G, *array.HyperLogLog[K]], error) {
+	return func(a []T) (*array.OrderedMap[G, *array.HyperLogLog[K]], error) {
+		if precision < 4 || precision > 18 {
+			return nil, fmt.Errorf("precision must be between 4 and 18")
+		}
+		return array.GroupHyperLogLogByOrdered(a, group, key, precision), nil
+	}
+}
+
+func GroupQuantileSketchBy[T any, K comparable, V Number](key func(T) K, value func(T) V, k int) func([]T) (map[K]*array.QuantileSketch[V], error) {
+	return func(a []T) (map[K]*array.QuantileSketch[V], error) {
+		if k < 8 {
+			return nil, fmt.Errorf("k must be at least 8")
+		}
+		return array.GroupQuantileSketchBy(a, key, value, k), nil
+	}
+}
+
+func GroupQuantileSketchByOrdered[T any, K comparable, V Number](key func(T) K, value func(T) V, k int) func([]T) (*array.OrderedMap[K, *array.QuantileSketch[V]], error) {
+	return func(a []T) (*array.OrderedMap[K, *array.QuantileSketch[V]], error) {
+		if k < 8 {
+			return nil, fmt.Errorf("k must be at least 8")
+		}
+		return array.GroupQuantileSketchByOrdered(a, key, value, k), nil
+	}
+}
+
+func ApproxQuantiles[T any, V Number](value func(T) V, qs ...float64) func([]T) ([]V, error) {
+	return func(a []T) ([]V, error) {
+		if len(a) == 0 {
+			return nil, fmt.Errorf("the input slice is empty")
+		}
+		return array.ApproxQuantiles(a, value, qs...), nil
+	}
+}
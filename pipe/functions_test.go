@@ -1,9 +1,17 @@
 package pipe
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/devalexandre/gofn/array"
 )
 
 func TestFilter(t *testing.T) {
@@ -42,6 +50,165 @@ func TestReduce(t *testing.T) {
 	}
 }
 
+func TestSliceFuncs(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	even := func(n int) bool { return n%2 == 0 }
+
+	found, err := Find(even)(input)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if found != 2 {
+		t.Errorf("Expected %v, got %v", 2, found)
+	}
+
+	found, err = FindOk(even)(input)
+	if err != nil || found != 2 {
+		t.Errorf("Expected %v, got %v (%v)", 2, found, err)
+	}
+	if _, err := FindOk(func(n int) bool { return n > 4 })(input); err == nil {
+		t.Errorf("Expected error when nothing matches")
+	}
+
+	flat, err := FlatMap(func(n int) []int { return []int{n, n} })(input[:2])
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(flat, []int{1, 1, 2, 2}) {
+		t.Errorf("Expected %v, got %v", []int{1, 1, 2, 2}, flat)
+	}
+
+	anyEven, err := Any(even)(input)
+	if err != nil || !anyEven {
+		t.Errorf("Expected Any to be true, got %v (%v)", anyEven, err)
+	}
+	someEven, err := Some(even)(input)
+	if err != nil || !someEven {
+		t.Errorf("Expected Some to be true, got %v (%v)", someEven, err)
+	}
+	everyEven, err := Every(even)(input)
+	if err != nil || everyEven {
+		t.Errorf("Expected Every to be false, got %v (%v)", everyEven, err)
+	}
+
+	union, err := Union([]int{5})(input)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(union, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected %v, got %v", []int{1, 2, 3, 4, 5}, union)
+	}
+
+	filled, err := Fill(1, 3, 0)(input)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(filled, []int{1, 0, 0, 4}) {
+		t.Errorf("Expected %v, got %v", []int{1, 0, 0, 4}, filled)
+	}
+	filled, err = Fill(-2, 9, 0)(input)
+	if err != nil || !reflect.DeepEqual(filled, []int{1, 2, 0, 0}) {
+		t.Errorf("Expected %v, got %v (%v)", []int{1, 2, 0, 0}, filled, err)
+	}
+
+	sliced, err := Slice[int](-3, -1)(input)
+	if err != nil || !reflect.DeepEqual(sliced, []int{2, 3}) {
+		t.Errorf("Expected %v, got %v (%v)", []int{2, 3}, sliced, err)
+	}
+	spliced, removed, err := Splice(1, 2, 9)(input)
+	if err != nil || !reflect.DeepEqual(spliced, []int{1, 9, 4}) || !reflect.DeepEqual(removed, []int{2, 3}) {
+		t.Errorf("Expected %v %v, got %v %v (%v)", []int{1, 9, 4}, []int{2, 3}, spliced, removed, err)
+	}
+	inserted, err := InsertAt(-1, 0)(input)
+	if err != nil || !reflect.DeepEqual(inserted, []int{1, 2, 3, 0, 4}) {
+		t.Errorf("Expected %v, got %v (%v)", []int{1, 2, 3, 0, 4}, inserted, err)
+	}
+	withoutFirst, err := RemoveAt[int](0)(input)
+	if err != nil || !reflect.DeepEqual(withoutFirst, []int{2, 3, 4}) {
+		t.Errorf("Expected %v, got %v (%v)", []int{2, 3, 4}, withoutFirst, err)
+	}
+	odd, err := RemoveWhere(even)(input)
+	if err != nil || !reflect.DeepEqual(odd, []int{1, 3}) {
+		t.Errorf("Expected %v, got %v (%v)", []int{1, 3}, odd, err)
+	}
+
+	prices, err := Pluck(func(n int) float64 { return float64(n) / 2 })(input)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if prices.Sum() != 5 {
+		t.Errorf("Expected %v, got %v", 5, prices.Sum())
+	}
+
+	if _, err := Sum[int]()(nil); err == nil {
+		t.Errorf("Expected error for empty Sum")
+	}
+	if _, err := Product[int]()(nil); err == nil {
+		t.Errorf("Expected error for empty Product")
+	}
+}
+
+func TestSampleFuncs(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6}
+
+	shuffled, err := ShuffleWith[int](array.NewRand(1))(input)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if exp := array.ShuffleWith(input, array.NewRand(1)); !reflect.DeepEqual(shuffled, exp) {
+		t.Errorf("Expected %v, got %v", exp, shuffled)
+	}
+
+	sample, err := Sample[int](3, array.NewRand(2))(input)
+	if err != nil || len(sample) != 3 {
+		t.Errorf("Expected 3 elements, got %v (%v)", sample, err)
+	}
+
+	repeated, err := SampleWithReplacement[int](10, array.NewRand(3))(input)
+	if err != nil || len(repeated) != 10 {
+		t.Errorf("Expected 10 elements, got %v (%v)", repeated, err)
+	}
+	if _, err := SampleWithReplacement[int](1, nil)(nil); err == nil {
+		t.Errorf("Expected error for empty SampleWithReplacement")
+	}
+
+	weighted, err := WeightedSample(2, func(n int) float64 { return float64(n % 2) }, array.NewRand(4))(input)
+	if err != nil || len(weighted) != 2 || weighted[0]%2 != 1 || weighted[1]%2 != 1 {
+		t.Errorf("Expected two odd elements, got %v (%v)", weighted, err)
+	}
+
+	reservoir, err := ReservoirSample[int](4, array.NewRand(5))(input)
+	if err != nil || len(reservoir) != 4 {
+		t.Errorf("Expected 4 elements, got %v (%v)", reservoir, err)
+	}
+	if _, err := ReservoirSample[int](-1, nil)(input); err == nil {
+		t.Errorf("Expected error for negative ReservoirSample size")
+	}
+}
+
+func TestFold(t *testing.T) {
+	f := Fold(0.5, func(acc float64, n int) float64 { return acc + float64(n) })
+	result, err := f([]int{1, 2, 3})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if result != 6.5 {
+		t.Errorf("Expected %v, got %v", 6.5, result)
+	}
+}
+
+func TestZip(t *testing.T) {
+	f := Zip([]string{"a", "b"}, func(n int, s string) string { return fmt.Sprint(s, n) })
+	result, err := f([]int{1, 2, 3})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := []string{"a1", "b2"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
 func TestSum(t *testing.T) {
 	f := Sum[int]()
 	result, err := f([]int{1, 2, 3, 4, 5})
@@ -66,6 +233,27 @@ func TestProduct(t *testing.T) {
 	}
 }
 
+func TestAvgStats(t *testing.T) {
+	avg, err := Avg[int]()([]int{1, 2, 3, 4})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if avg != 2.5 {
+		t.Errorf("Expected %v, got %v", 2.5, avg)
+	}
+	if _, err := Avg[int]()(nil); err == nil {
+		t.Errorf("Expected error for empty slice")
+	}
+
+	stats, err := Stats[int]()([]int{4, 1, 3})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if stats.Count != 3 || stats.Sum != 8 || stats.Min != 1 || stats.Max != 4 {
+		t.Errorf("Expected count 3 sum 8 min 1 max 4, got %v", stats)
+	}
+}
+
 func TestMin(t *testing.T) {
 	f := Min[int]()
 	result, err := f([]int{5, 2, 3, 4, 1})
@@ -151,6 +339,68 @@ func TestIndexOf(t *testing.T) {
 	}
 }
 
+func TestComparableFuncs(t *testing.T) {
+	input := []int{1, 2, 2, 3, 4}
+
+	equal, err := Equals([]int{1, 2, 2, 3, 4})(input)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !equal {
+		t.Errorf("Expected slices to be equal")
+	}
+
+	intersection, err := Intersect([]int{2, 4, 6})(input)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(intersection, []int{2, 4}) {
+		t.Errorf("Expected %v, got %v", []int{2, 4}, intersection)
+	}
+
+	difference, err := Difference([]int{2, 4, 6})(input)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(difference, []int{1, 3}) {
+		t.Errorf("Expected %v, got %v", []int{1, 3}, difference)
+	}
+
+	count, err := Count(2)(input)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected %d, got %d", 2, count)
+	}
+
+	frequencies, err := Frequencies[int]()(input)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(frequencies, map[int]int{1: 1, 2: 2, 3: 1, 4: 1}) {
+		t.Errorf("Expected frequencies by value, got %v", frequencies)
+	}
+}
+
+func TestSetFuncs(t *testing.T) {
+	set, err := ToSet[int]()([]int{3, 1, 3})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(set, array.NewSet(1, 3)) {
+		t.Errorf("Expected %v, got %v", array.NewSet(1, 3), set)
+	}
+
+	lengths, err := ToSetBy(func(s string) int { return len(s) })([]string{"a", "bb", "c"})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(lengths, array.NewSet(1, 2)) {
+		t.Errorf("Expected %v, got %v", array.NewSet(1, 2), lengths)
+	}
+}
+
 func TestForEach(t *testing.T) {
 	a := []int{1, 2, 3, 4, 5}
 	sum := 0
@@ -262,6 +512,11 @@ func TestGroupByFunc(t *testing.T) {
 		t.Errorf("Expected %d groups, got %d", expectedGroupCount, len(groupedItems))
 	}
 
+	group := groupedItems["Item 4 - 40.0"]
+	if len(group) != 3 || group[0].Qty != 10 || group[2].Qty != 25 {
+		t.Errorf("Expected every Item 4 row in original order, got %v", group)
+	}
+
 }
 
 func TestGroupSumByFunc(t *testing.T) {
@@ -337,6 +592,14 @@ func TestRowHelperFuncs(t *testing.T) {
 		t.Errorf("Expected %d, got %d", 3, count["Item 4"])
 	}
 
+	counter, err := CounterBy(func(item Item) string { return item.Name })(items)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if top := counter.MostCommon(1); top[0].Key != "Item 4" {
+		t.Errorf("Expected Item 4 to be the most common, got %v", top)
+	}
+
 	summary, err := GroupReduceBy(
 		func(item Item) string { return item.Name },
 		func(acc Summary, item Item) Summary {
@@ -419,3 +682,502 @@ func TestRowHelperFuncs(t *testing.T) {
 		t.Errorf("Expected three chunks with two items, got %v", chunks)
 	}
 }
+
+func TestGroupByTimeFuncs(t *testing.T) {
+	type Event struct {
+		At    time.Time
+		Value int
+	}
+
+	events := []Event{
+		{time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), 1},
+		{time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), 2},
+		{time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC), 3},
+	}
+	at := func(e Event) time.Time { return e.At }
+	value := func(e Event) int { return e.Value }
+	bucket := array.DayBucket(time.UTC).FillGaps()
+	jan1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	jan2 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	grouped, err := GroupByTime(at, bucket)(events)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if len(grouped) != 3 || len(grouped[jan1]) != 2 || len(grouped[jan2]) != 0 {
+		t.Errorf("Expected three daily buckets, got %v", grouped)
+	}
+
+	sum, err := GroupSumByTime(at, value, bucket)(events)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if sum[jan1] != 3 || sum[jan2] != 0 {
+		t.Errorf("Expected sums 3 and 0, got %v", sum)
+	}
+
+	count, err := GroupCountByTime(at, bucket)(events)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if count[jan1] != 2 {
+		t.Errorf("Expected %d, got %d", 2, count[jan1])
+	}
+
+	stats, err := GroupStatsByTime(at, value, bucket)(events)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if stats[jan1].Max != 2 || stats[jan2].Count != 0 {
+		t.Errorf("Expected max 2 and an empty bucket, got %v", stats)
+	}
+
+	if _, err := GroupByTime(at, bucket)(nil); err == nil {
+		t.Errorf("Expected error for empty slice")
+	}
+}
+
+func TestWindowFuncs(t *testing.T) {
+	type Sale struct {
+		Category string
+		Month    int
+		Total    int
+	}
+
+	sales := []Sale{
+		{"books", 2, 30},
+		{"games", 1, 50},
+		{"books", 1, 10},
+		{"books", 3, 30},
+	}
+	category := func(s Sale) string { return s.Category }
+	month := func(s Sale) int { return s.Month }
+	total := func(s Sale) int { return s.Total }
+	values := func(rows []array.WindowRow[Sale, int]) []int {
+		return array.Map(rows, func(r array.WindowRow[Sale, int]) int { return r.Value })
+	}
+
+	numbered, err := RowNumber(category, month)(sales)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := values(numbered); !reflect.DeepEqual(got, []int{2, 1, 1, 3}) {
+		t.Errorf("Expected %v, got %v", []int{2, 1, 1, 3}, got)
+	}
+
+	ranked, err := Rank(category, total)(sales)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := values(ranked); !reflect.DeepEqual(got, []int{2, 1, 1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{2, 1, 1, 2}, got)
+	}
+
+	dense, err := DenseRank(category, total)(sales)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := values(dense); !reflect.DeepEqual(got, []int{2, 1, 1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{2, 1, 1, 2}, got)
+	}
+
+	percent, err := PercentRank(category, total)(sales)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if percent[0].Value != 0.5 || percent[1].Value != 0 {
+		t.Errorf("Expected percent ranks 0.5 and 0, got %v", percent)
+	}
+
+	lag, err := Lag(category, month, total, 1, 0)(sales)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := values(lag); !reflect.DeepEqual(got, []int{10, 0, 0, 30}) {
+		t.Errorf("Expected %v, got %v", []int{10, 0, 0, 30}, got)
+	}
+
+	lead, err := Lead(category, month, total, 1, 0)(sales)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := values(lead); !reflect.DeepEqual(got, []int{30, 0, 30, 0}) {
+		t.Errorf("Expected %v, got %v", []int{30, 0, 30, 0}, got)
+	}
+
+	sum, err := CumSum(category, month, total)(sales)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := values(sum); !reflect.DeepEqual(got, []int{40, 50, 10, 70}) {
+		t.Errorf("Expected %v, got %v", []int{40, 50, 10, 70}, got)
+	}
+
+	avg, err := MovingAvg(category, month, total, 2)(sales)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if avg[0].Value != 20 || avg[3].Value != 30 {
+		t.Errorf("Expected moving averages 20 and 30, got %v", avg)
+	}
+
+	if _, err := MovingAvg(category, month, total, 0)(sales); err == nil {
+		t.Errorf("Expected error for non-positive window size")
+	}
+}
+
+func TestApproxFuncs(t *testing.T) {
+	values := make([]int, 10000)
+	for i := range values {
+		values[i] = i % 2500
+	}
+	identity := func(x int) int { return x }
+
+	distinct, err := ApproxCountDistinct(identity)(values)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if distinct < 2400 || distinct > 2600 {
+		t.Errorf("Expected about 2500 distinct values, got %d", distinct)
+	}
+
+	quantiles, err := ApproxQuantiles(identity, 0.5)(values)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if quantiles[0] < 1200 || quantiles[0] > 1300 {
+		t.Errorf("Expected a median of about 1250, got %v", quantiles)
+	}
+
+	byParity := func(x int) bool { return x%2 == 0 }
+	sketches, err := GroupHyperLogLogBy(byParity, identity, 12)(values)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := sketches[true].Count(); got < 1200 || got > 1300 {
+		t.Errorf("Expected about 1250 distinct even values, got %d", got)
+	}
+
+	quantileSketches, err := GroupQuantileSketchBy(byParity, identity, 200)(values)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := quantileSketches[false].Quantile(1); got != 2499 {
+		t.Errorf("Expected max odd value 2499, got %d", got)
+	}
+
+	if _, err := ApproxQuantiles(identity, 0.5)(nil); err == nil {
+		t.Errorf("Expected error for empty slice")
+	}
+	for _, precision := range []uint8{3, 19} {
+		if _, err := GroupHyperLogLogBy(byParity, identity, precision)(values); err == nil {
+			t.Errorf("Expected error for precision %d", precision)
+		}
+		if _, err := GroupHyperLogLogByOrdered(byParity, identity, precision)(values); err == nil {
+			t.Errorf("Expected error for precision %d", precision)
+		}
+	}
+	if _, err := GroupQuantileSketchBy(byParity, identity, 7)(values); err == nil {
+		t.Errorf("Expected error for k 7")
+	}
+	if _, err := GroupQuantileSketchByOrdered(byParity, identity, 7)(values); err == nil {
+		t.Errorf("Expected error for k 7")
+	}
+}
+
+func TestPerGroup(t *testing.T) {
+	type Order struct {
+		Customer string
+		Day      int
+	}
+
+	orders := []Order{
+		{"ann", 1}, {"bob", 4}, {"ann", 7}, {"ann", 3}, {"bob", 2}, {"ann", 5},
+	}
+	customer := func(o Order) string { return o.Customer }
+
+	groups, err := Groups(customer)(orders)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if len(groups) != 2 || groups[0].Key != "ann" || len(groups[0].Items) != 4 {
+		t.Errorf("Expected ann then bob groups, got %v", groups)
+	}
+
+	latest := PerGroup(customer, Then(
+		SortBy(func(o Order) int { return -o.Day }),
+		Take[Order](2),
+	))
+	result, err := latest(orders)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := map[string][]Order{
+		"ann": {{"ann", 7}, {"ann", 5}},
+		"bob": {{"bob", 4}, {"bob", 2}},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	failing := PerGroup(customer, Then(
+		Filter(func(o Order) bool { return o.Day > 4 }),
+		Filter(func(o Order) bool { return true }),
+	))
+	if _, err := failing(orders); err == nil || !strings.Contains(err.Error(), "group bob") {
+		t.Errorf("Expected error for group bob, got %v", err)
+	}
+}
+
+func TestOrderedFuncs(t *testing.T) {
+	counts, err := GroupCountByOrdered(func(s string) string { return s })([]string{"b", "a", "b"})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if keys := slices.Collect(counts.Keys()); !reflect.DeepEqual(keys, []string{"b", "a"}) {
+		t.Errorf("Expected %v, got %v", []string{"b", "a"}, keys)
+	}
+
+	if _, err := GroupByOrdered(func(s string) string { return s })(nil); err == nil {
+		t.Errorf("Expected error for empty slice")
+	}
+}
+
+func TestHeapFuncs(t *testing.T) {
+	top, err := TopK(2, cmp.Compare[int])([]int{3, 9, 1, 7})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(top, []int{9, 7}) {
+		t.Errorf("Expected %v, got %v", []int{9, 7}, top)
+	}
+	if _, err := TopKBy(-1, func(s string) int { return len(s) })([]string{"a"}); err == nil {
+		t.Errorf("Expected error for negative k")
+	}
+
+	merged, err := MergeSorted(cmp.Compare[int])([][]int{{1, 3}, {2}})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(merged, []int{1, 2, 3}) {
+		t.Errorf("Expected %v, got %v", []int{1, 2, 3}, merged)
+	}
+
+	workers, err := Schedule(2, func(n int) int { return n })([]int{4, 1, 1})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(workers, [][]int{{4}, {1, 1}}) {
+		t.Errorf("Expected %v, got %v", [][]int{{4}, {1, 1}}, workers)
+	}
+	if _, err := Schedule(0, func(n int) int { return n })([]int{1}); err == nil {
+		t.Errorf("Expected error for no workers")
+	}
+}
+
+func TestSortedFuncs(t *testing.T) {
+	id := func(n int) int { return n }
+	a := []int{1, 3, 3, 7}
+
+	found, err := BinarySearchBy(3, id)(a)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if found != array.PairOf(1, true) {
+		t.Errorf("Expected {1 true}, got %v", found)
+	}
+
+	bounds, err := Then(Filter(func(n int) bool { return n > 1 }), EqualRange(3, id))(a)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if bounds != array.PairOf(0, 2) {
+		t.Errorf("Expected {0 2}, got %v", bounds)
+	}
+
+	upper, err := UpperBound(3, id)(a)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if upper != 3 {
+		t.Errorf("Expected %d, got %d", 3, upper)
+	}
+
+	between, err := RangeBy(2, 7, id)(a)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(between, []int{3, 3, 7}) {
+		t.Errorf("Expected %v, got %v", []int{3, 3, 7}, between)
+	}
+}
+
+func TestOptionFuncs(t *testing.T) {
+	found, err := FindOption(func(n int) bool { return n == 0 })([]int{1, 0})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !found.IsSome() {
+		t.Errorf("Expected the zero value to be found")
+	}
+
+	minimum, err := MinOption[int]()(nil)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if minimum.IsSome() {
+		t.Errorf("Expected None, got %v", minimum)
+	}
+
+	total, err := SumOption[int]()([]int{1, 2, 3})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if total.OrElse(0) != 6 {
+		t.Errorf("Expected %v, got %v", 6, total)
+	}
+	product, err := ProductOption[int]()(nil)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if product.IsSome() {
+		t.Errorf("Expected None, got %v", product)
+	}
+
+	parse := func(s string) array.Result[int] { return array.ResultOf(strconv.Atoi(s)) }
+	numbers, err := Lift(parse)([]string{"1", "2"})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(numbers, []int{1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{1, 2}, numbers)
+	}
+	if _, err := Lift(parse)([]string{"1", "x"}); err == nil || !strings.HasPrefix(err.Error(), "element 1:") {
+		t.Errorf("Expected error for element 1, got %v", err)
+	}
+}
+
+func TestPairFuncs(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+
+	popped, err := Then(Filter(even), PopPair[int]())([]int{1, 2, 3, 4})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if popped.First != 4 || !reflect.DeepEqual(popped.Second, []int{2}) {
+		t.Errorf("Expected 4 [2], got %v", popped)
+	}
+	if _, err := ShiftPair[int]()(nil); err == nil {
+		t.Errorf("Expected error for empty slice")
+	}
+
+	spliced, err := SplicePair(0, 1, 9)([]int{1, 2})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(spliced, array.PairOf([]int{9, 2}, []int{1})) {
+		t.Errorf("Expected [9 2] [1], got %v", spliced)
+	}
+
+	sides, err := PartitionPair(even)([]int{1, 2, 3})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(sides, array.PairOf([]int{2}, []int{1, 3})) {
+		t.Errorf("Expected [2] [1 3], got %v", sides)
+	}
+
+	rest, err := Then(KeepRest(even), Map(func(n int) int { return n * 10 }))([]int{1, 2, 3})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(rest, []int{10, 30}) {
+		t.Errorf("Expected %v, got %v", []int{10, 30}, rest)
+	}
+	if matched, _ := KeepMatched(even)([]int{1, 2, 3}); !reflect.DeepEqual(matched, []int{2}) {
+		t.Errorf("Expected %v, got %v", []int{2}, matched)
+	}
+}
+
+func TestRoute(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+
+	routed, err := Route(even, Sum[int](), Max[int]())([]int{1, 2, 3, 4})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if routed != array.PairOf(6, 3) {
+		t.Errorf("Expected {6 3}, got %v", routed)
+	}
+
+	if _, err := Route(even, Sum[int](), Max[int]())([]int{2}); err == nil || !strings.HasPrefix(err.Error(), "rest:") {
+		t.Errorf("Expected error from the rest stage, got %v", err)
+	}
+}
+
+func TestMatrixFuncs(t *testing.T) {
+	flat, err := Then(Transpose(0), Flatten[int]())([][]int{{1, 2}, {3}})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(flat, []int{1, 3, 2, 0}) {
+		t.Errorf("Expected %v, got %v", []int{1, 3, 2, 0}, flat)
+	}
+
+	rotated, err := RotateMatrix(-1, 0)([][]int{{1, 2}, {3, 4}})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(rotated, [][]int{{2, 4}, {1, 3}}) {
+		t.Errorf("Expected %v, got %v", [][]int{{2, 4}, {1, 3}}, rotated)
+	}
+
+	product, err := Cartesian([]int{3, 4})([]int{1})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(product, [][]int{{1, 3}, {1, 4}}) {
+		t.Errorf("Expected %v, got %v", [][]int{{1, 3}, {1, 4}}, product)
+	}
+}
+
+func TestBuildTree(t *testing.T) {
+	type item struct{ ID, Parent int }
+	build := BuildTree(func(i item) int { return i.ID }, func(i item) int { return i.Parent })
+
+	roots, err := build([]item{{1, 0}, {2, 1}, {3, 1}})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if len(roots) != 1 || roots[0].Size() != 3 {
+		t.Errorf("Expected one tree of 3 nodes, got %v", roots)
+	}
+
+	_, err = build([]item{{1, 0}, {2, 9}})
+	var treeErr *array.TreeError[item]
+	if !errors.As(fmt.Errorf("stage: %w", err), &treeErr) {
+		t.Fatalf("Expected a TreeError, got %v", err)
+	}
+	if orphans := treeErr.Report.Orphans; len(orphans) != 1 || orphans[0].Value.ID != 2 {
+		t.Errorf("Expected orphan 2, got %v", orphans)
+	}
+}
+
+func TestPerGroupOrdered(t *testing.T) {
+	lengths := PerGroupOrdered(func(s string) string { return s[:1] }, Map(func(s string) int { return len(s) }))
+	result, err := lengths([]string{"bob", "ann", "bea"})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if keys := slices.Collect(result.Keys()); !reflect.DeepEqual(keys, []string{"b", "a"}) {
+		t.Errorf("Expected %v, got %v", []string{"b", "a"}, keys)
+	}
+	if b, _ := result.Get("b"); !reflect.DeepEqual(b, []int{3, 3}) {
+		t.Errorf("Expected %v, got %v", []int{3, 3}, b)
+	}
+
+	failing := PerGroupOrdered(func(n int) int { return n }, func([]int) (int, error) { return 0, fmt.Errorf("boom") })
+	if _, err := failing([]int{1}); err == nil || err.Error() != "group 1: boom" {
+		t.Errorf("Expected a group error, got %v", err)
+	}
+}
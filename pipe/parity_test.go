@@ -0,0 +1,175 @@
+package pipe
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// Array functions that intentionally have no chain method, and why.
+var chainExceptions = map[string]string{
+	"NewCounter":   "constructor",
+	"Fold":         "chain form is the FoldTo bridge",
+	"Zip":          "chain form is the ZipTo bridge",
+	"SortBy":       "chain form is SortByString / SortByFloat64",
+	"SortBy0Loc":   "chain form is Mutable.SortByKey",
+	"Flatten":      "chain form is Nested.Flatten",
+	"Transpose":    "chain form is Nested.Transpose",
+	"RotateMatrix": "chain form is Nested.Rotate",
+}
+
+// Array functions that intentionally have no pipe stage, and why.
+var pipeExceptions = map[string]string{
+	"NewCounter": "constructor",
+}
+
+// chainTypes are the chain types; every one of them must carry the methods of Array.
+var chainTypes = []string{"Array", "Numbers", "ComparableArray"}
+
+// mutableTypes are the mutable chain types. The in-place functions (suffix 0Loc)
+// are their methods, without the suffix, and they have no pipe stage since
+// stages never mutate their input.
+var mutableTypes = []string{"Mutable", "MutableComparable"}
+
+const inPlaceSuffix = "0Loc"
+
+type declarations struct {
+	funcs   map[string]bool
+	methods map[string]map[string]bool
+}
+
+// parseDecls lists the exported functions of the package in dir that take a
+// slice or an iter.Seq as first parameter, and the exported methods of each type.
+func parseDecls(t *testing.T, dir string) declarations {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	decls := declarations{funcs: map[string]bool{}, methods: map[string]map[string]bool{}}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, d := range file.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() {
+				continue
+			}
+			if fn.Recv != nil {
+				recv := receiverName(fn.Recv.List[0].Type)
+				if decls.methods[recv] == nil {
+					decls.methods[recv] = map[string]bool{}
+				}
+				decls.methods[recv][fn.Name.Name] = true
+				continue
+			}
+			decls.funcs[fn.Name.Name] = takesSlice(fn)
+		}
+	}
+
+	return decls
+}
+
+func receiverName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(e.X)
+	case *ast.IndexExpr:
+		return receiverName(e.X)
+	case *ast.IndexListExpr:
+		return receiverName(e.X)
+	case *ast.Ident:
+		return e.Name
+	}
+	return ""
+}
+
+func takesSlice(fn *ast.FuncDecl) bool {
+	params := fn.Type.Params.List
+	if len(params) == 0 {
+		return false
+	}
+	switch p := params[0].Type.(type) {
+	case *ast.ArrayType:
+		return p.Len == nil
+	case *ast.IndexExpr:
+		// iter.Seq[T] streams the elements of a slice.
+		sel, ok := p.X.(*ast.SelectorExpr)
+		return ok && sel.Sel.Name == "Seq" && receiverName(sel.X) == "iter"
+	}
+	return false
+}
+
+func TestAPIParity(t *testing.T) {
+	arrayDecls := parseDecls(t, "../array")
+	pipeDecls := parseDecls(t, ".")
+
+	var operations []string
+	for name, slice := range arrayDecls.funcs {
+		if slice {
+			operations = append(operations, name)
+		}
+	}
+	sort.Strings(operations)
+
+	for _, name := range operations {
+		if strings.HasSuffix(name, inPlaceSuffix) {
+			if _, ok := chainExceptions[name]; !ok {
+				method := strings.TrimSuffix(name, inPlaceSuffix)
+				found := false
+				for _, chain := range mutableTypes {
+					found = found || arrayDecls.methods[chain][method]
+				}
+				if !found {
+					t.Errorf("array.%s has no %s method on %s", name, method, strings.Join(mutableTypes, ", "))
+				}
+			}
+			continue
+		}
+		if _, ok := chainExceptions[name]; !ok {
+			found := false
+			for _, chain := range chainTypes {
+				found = found || arrayDecls.methods[chain][name]
+			}
+			if !found {
+				t.Errorf("array.%s has no chain method on %s", name, strings.Join(chainTypes, ", "))
+			}
+		}
+		if _, ok := pipeExceptions[name]; !ok && !hasFunc(pipeDecls, name) {
+			t.Errorf("array.%s has no pipe stage", name)
+		}
+	}
+
+	for method := range arrayDecls.methods["Mutable"] {
+		if !arrayDecls.methods["MutableComparable"][method] {
+			t.Errorf("Mutable.%s is missing on MutableComparable", method)
+		}
+	}
+
+	for method := range arrayDecls.methods["Array"] {
+		for _, chain := range chainTypes[1:] {
+			if !arrayDecls.methods[chain][method] {
+				t.Errorf("Array.%s is missing on %s", method, chain)
+			}
+		}
+	}
+}
+
+func hasFunc(d declarations, name string) bool {
+	_, ok := d.funcs[name]
+	return ok
+}